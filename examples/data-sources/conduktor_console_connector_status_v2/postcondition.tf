@@ -0,0 +1,25 @@
+resource "conduktor_console_connector_v2" "pageviews" {
+  name            = "pageviews"
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  spec = {
+    config = {
+      "connector.class" = "org.apache.kafka.connect.tools.MockSourceConnector"
+      "tasks.max"       = "1"
+      "topic"           = "click.pageviews"
+    }
+  }
+}
+
+data "conduktor_console_connector_status_v2" "pageviews" {
+  cluster         = conduktor_console_connector_v2.pageviews.cluster
+  connect_cluster = conduktor_console_connector_v2.pageviews.connect_cluster
+  name            = conduktor_console_connector_v2.pageviews.name
+
+  lifecycle {
+    postcondition {
+      condition     = self.state == "RUNNING" && alltrue([for task in self.tasks : task.state == "RUNNING"])
+      error_message = "Connector ${self.name} is ${self.state}: ${join(", ", [for task in self.tasks : "task ${task.id} ${task.state}"])}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "conduktor_console_connector_status_v2" "example" {
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  name            = "my-connector"
+}
+
+output "connector_state" {
+  value = data.conduktor_console_connector_status_v2.example.state
+}
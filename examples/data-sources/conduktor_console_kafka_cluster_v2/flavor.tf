@@ -0,0 +1,22 @@
+data "conduktor_console_kafka_cluster_v2" "shared" {
+  name = "shared-cluster"
+}
+
+locals {
+  # Only the nested block matching the flavor type is set, the others are null.
+  shared_is_confluent = try(data.conduktor_console_kafka_cluster_v2.shared.spec.kafka_flavor.confluent != null, false)
+}
+
+resource "conduktor_console_topic_v2" "orders" {
+  name    = "orders"
+  cluster = data.conduktor_console_kafka_cluster_v2.shared.name
+  labels  = data.conduktor_console_kafka_cluster_v2.shared.labels
+  spec = {
+    partitions         = 3
+    replication_factor = local.shared_is_confluent ? 3 : 1
+  }
+}
+
+output "shared_schema_registry_url" {
+  value = try(data.conduktor_console_kafka_cluster_v2.shared.spec.schema_registry.confluent_like.url, null)
+}
@@ -0,0 +1,7 @@
+data "conduktor_console_kafka_cluster_v2" "shared" {
+  name = "shared-cluster"
+}
+
+output "shared_bootstrap_servers" {
+  value = data.conduktor_console_kafka_cluster_v2.shared.spec.bootstrap_servers
+}
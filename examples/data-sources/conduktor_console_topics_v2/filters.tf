@@ -0,0 +1,31 @@
+data "conduktor_console_topics_v2" "sales" {
+  cluster    = "kafka-cluster"
+  name_regex = "^sales-"
+  labels = {
+    team = "sales"
+  }
+}
+
+# Grant read access on every topic owned by the sales team
+resource "conduktor_console_service_account_v1" "sales_reader" {
+  name    = "sales-reader"
+  cluster = "kafka-cluster"
+  spec = {
+    authorization = {
+      kafka = {
+        acls = [
+          for topic in data.conduktor_console_topics_v2.sales.topics : {
+            name         = topic.name
+            pattern_type = "LITERAL"
+            operations   = ["Read"]
+            type         = "TOPIC"
+          }
+        ]
+      }
+    }
+  }
+}
+
+output "sales_partitions" {
+  value = { for topic in data.conduktor_console_topics_v2.sales.topics : topic.name => topic.spec.partitions }
+}
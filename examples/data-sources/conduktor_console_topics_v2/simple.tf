@@ -0,0 +1,7 @@
+data "conduktor_console_topics_v2" "all" {
+  cluster = "kafka-cluster"
+}
+
+output "topic_names" {
+  value = data.conduktor_console_topics_v2.all.names
+}
@@ -0,0 +1,16 @@
+ephemeral "conduktor_gateway_token_v2" "app" {
+  vcluster         = "vcluster_sa"
+  username         = "user10"
+  lifetime_seconds = 3600
+}
+
+# Write-only argument, the token is never stored in the state of either resource
+resource "kubernetes_secret_v1" "app" {
+  metadata {
+    name = "gateway-credentials"
+  }
+  data_wo = {
+    "sasl.jaas.config" = "org.apache.kafka.common.security.plain.PlainLoginModule required username='user10' password='${ephemeral.conduktor_gateway_token_v2.app.token}';"
+  }
+  data_wo_revision = 1
+}
@@ -0,0 +1,37 @@
+locals {
+  topic_policy = {
+    target_kind = "Topic"
+    rules = [
+      {
+        condition     = "metadata.name.matches(\"^click\\\\.[a-z0-9-]+\\\\.(avro|json)$\")"
+        error_message = "topic name should match click.<event>.(avro|json)"
+      },
+      {
+        condition     = "int(string(spec.configs[\"retention.ms\"])) <= 3600000"
+        error_message = "retention should be at most 1 hour"
+      },
+    ]
+  }
+
+  # Error messages of the rules not satisfied by the topic, empty when compliant
+  violations = provider::conduktor::evaluate_resource_policy(local.topic_policy, {
+    kind = "Topic"
+    metadata = {
+      name    = "click.events.avro"
+      cluster = "kafka-cluster"
+    }
+    spec = {
+      partitions = 3
+      configs = {
+        "retention.ms" = "60000"
+      }
+    }
+  })
+}
+
+check "topic_policy" {
+  assert {
+    condition     = length(local.violations) == 0
+    error_message = join(", ", local.violations)
+  }
+}
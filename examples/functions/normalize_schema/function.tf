@@ -0,0 +1,25 @@
+locals {
+  user_schema = file("${path.module}/schemas/user.avsc")
+}
+
+# Only changes when the schema meaning changes, not on formatting changes
+resource "terraform_data" "user_schema_hash" {
+  input = sha256(provider::conduktor::normalize_schema(local.user_schema))
+}
+
+resource "conduktor_console_connector_v2" "user_sink" {
+  name            = "user-sink"
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  spec = {
+    config = {
+      "connector.class" = "org.apache.kafka.connect.tools.MockSinkConnector"
+      "tasks.max"       = "1"
+      "topics"          = "user"
+    }
+  }
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.user_schema_hash]
+  }
+}
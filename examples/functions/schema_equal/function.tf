@@ -0,0 +1,15 @@
+locals {
+  # Generated schemas only differing from the base one by their formatting are skipped
+  schemas = {
+    for name, schema in var.generated_schemas : name => schema
+    if !provider::conduktor::schema_equal(schema, file("${path.module}/schemas/base.avsc"))
+  }
+}
+
+variable "generated_schemas" {
+  type = map(string)
+}
+
+output "changed_schemas" {
+  value = keys(local.schemas)
+}
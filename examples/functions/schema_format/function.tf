@@ -0,0 +1,19 @@
+locals {
+  schema = file("${path.module}/schemas/user.proto")
+}
+
+resource "conduktor_console_kafka_subject_v2" "user" {
+  name    = "user-value"
+  cluster = "kafka-cluster"
+  spec = {
+    format = provider::conduktor::schema_format(local.schema)
+    schema = local.schema
+  }
+
+  lifecycle {
+    precondition {
+      condition     = provider::conduktor::schema_format(local.schema) != "UNKNOWN"
+      error_message = "The user schema is neither a valid Avro, Protobuf nor JSON schema."
+    }
+  }
+}
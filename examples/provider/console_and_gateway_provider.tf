@@ -0,0 +1,26 @@
+provider "conduktor" {
+  console {
+    base_url  = "http://localhost:8080"
+    api_token = "your-api-token"
+    #admin_user     = "admin@my-org.com"
+    #admin_password = "admin-password"
+
+    insecure = true
+  }
+
+  gateway {
+    base_url       = "http://localhost:8888"
+    admin_user     = "admin"
+    admin_password = "admin-password"
+    insecure       = true
+  }
+}
+
+# Each resource uses the client of its own API
+resource "conduktor_gateway_virtual_cluster_v2" "vcluster" {
+  # ...
+}
+
+resource "conduktor_console_kafka_cluster_v2" "vcluster" {
+  # ...
+}
@@ -15,4 +15,19 @@ provider "conduktor" {
   # optional authentication via certificate
   key    = file("path/to/key.pem") # or env var CDK_CONSOLE_KEY or CDK_KEY
   cacert = file("path/to/ca.pem")  # or env var CDK_CONSOLE_CA_CERT CDK_CA_CERT
+
+  # optional maximum duration of each API request
+  request_timeout = "30s" # or env var CDK_REQUEST_TIMEOUT
+
+  # optional retry policy of requests failing with a transient error (rate limiting, rolling restart...)
+  retry = {
+    max_attempts           = 5
+    base_backoff           = "1s"
+    max_backoff            = "1m"
+    jitter                 = true
+    retryable_status_codes = [429, 502, 503, 504]
+  }
+
+  # optional patterns of the JSON keys masked in TRACE logs, on top of the default credentials ones
+  log_redact_patterns = ["^apiKey$"] # or env var CDK_LOG_REDACT_PATTERNS
 }
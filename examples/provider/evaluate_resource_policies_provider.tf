@@ -0,0 +1,6 @@
+provider "conduktor" {
+  mode                       = "console"
+  base_url                   = "http://localhost:8080"
+  api_token                  = "your-api-token"
+  evaluate_resource_policies = true # or env var CDK_EVALUATE_RESOURCE_POLICIES
+}
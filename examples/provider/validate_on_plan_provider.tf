@@ -0,0 +1,6 @@
+provider "conduktor" {
+  mode             = "console"
+  base_url         = "http://localhost:8080"
+  api_token        = "your-api-token"
+  validate_on_plan = true # or env var CDK_VALIDATE_ON_PLAN
+}
@@ -0,0 +1,35 @@
+resource "conduktor_console_user_v2" "owner" {
+  name = "alert.owner@company.io"
+  spec = {
+    firstname = "Alert"
+    lastname  = "Owner"
+  }
+}
+
+resource "conduktor_console_alert_v3" "complex" {
+  name = "orders-consumer-lag"
+  user = conduktor_console_user_v2.owner.name
+  spec = {
+    cluster             = "kafka-cluster"
+    type                = "ConsumerGroupAlert"
+    consumer_group_name = "orders-consumer"
+    metric              = "OffsetLag"
+    operator            = "GreaterThanOrEqual"
+    threshold           = 5000
+    description         = "Orders consumer is lagging behind"
+    disable             = false
+    destination = {
+      type   = "Webhook"
+      url    = "https://alerts.company.io/hooks/kafka"
+      method = "POST"
+      headers = {
+        "Authorization" = "Bearer token"
+      }
+      body = jsonencode({ alert = "orders-consumer-lag" })
+    }
+  }
+}
+
+output "orders_consumer_lag_prom_ql" {
+  value = conduktor_console_alert_v3.complex.spec.prom_ql
+}
@@ -0,0 +1,4 @@
+import {
+  to = conduktor_console_alert_v3.example
+  id = "group/team/orders-volume" # Import <owner_type>/<owner>/<alert_name> Alert
+}
@@ -0,0 +1,23 @@
+resource "conduktor_console_group_v2" "team" {
+  name = "team"
+  spec = {
+    display_name = "Team"
+  }
+}
+
+resource "conduktor_console_alert_v3" "simple" {
+  name  = "orders-volume"
+  group = conduktor_console_group_v2.team.name
+  spec = {
+    cluster    = "kafka-cluster"
+    type       = "TopicAlert"
+    topic_name = "orders"
+    metric     = "MessageCount"
+    operator   = "GreaterThan"
+    threshold  = 1000000
+    destination = {
+      type    = "Slack"
+      channel = "kafka-alerts"
+    }
+  }
+}
@@ -0,0 +1,28 @@
+
+variable "database_password_version" {
+  type = string
+}
+
+resource "conduktor_console_connector_v2" "lifecycle" {
+  name            = "lifecycle"
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  state           = "PAUSED" # paused during the migration, set back to RUNNING to resume it
+  restart_triggers = {
+    # restart the connector and its failed tasks when the database password is rotated
+    database_password = var.database_password_version
+  }
+  spec = {
+    config = {
+      "connector.class"     = "io.confluent.connect.jdbc.JdbcSourceConnector"
+      "tasks.max"           = "1"
+      "topic.prefix"        = "db."
+      "connection.url"      = "jdbc:postgresql://postgres:5432/app"
+      "connection.password" = "$${vault:secret/data/app:db_password}"
+    }
+  }
+}
+
+output "failed_tasks" {
+  value = [for task in conduktor_console_connector_v2.lifecycle.tasks : task.id if task.state == "FAILED"]
+}
@@ -0,0 +1,18 @@
+
+resource "conduktor_console_connector_v2" "wait_for_running" {
+  name            = "wait-for-running"
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  wait_for_running = {
+    timeout           = "2m"
+    poll_interval     = "10s"
+    min_running_tasks = 2
+  }
+  spec = {
+    config = {
+      "connector.class" = "org.apache.kafka.connect.tools.MockSourceConnector"
+      "tasks.max"       = "3"
+      "topic"           = "click.pageviews"
+    }
+  }
+}
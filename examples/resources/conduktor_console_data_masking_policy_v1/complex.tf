@@ -0,0 +1,30 @@
+resource "conduktor_console_group_v2" "compliance" {
+  name = "compliance"
+  spec = {
+    display_name = "Compliance"
+  }
+}
+
+resource "conduktor_console_group_v2" "payments" {
+  name = "payments"
+  spec = {
+    display_name = "Payments"
+  }
+}
+
+resource "conduktor_console_data_masking_policy_v1" "complex" {
+  name = "mask-payment-data"
+  spec = {
+    description = "Only show the last digits of payment data"
+    fields      = ["payment.cardNumber", "payment.iban"]
+    rule = {
+      type            = "MASK_LAST_N"
+      masking_char    = "X"
+      number_of_chars = 12
+    }
+    exempted_groups = [
+      conduktor_console_group_v2.compliance.name,
+      conduktor_console_group_v2.payments.name,
+    ]
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = conduktor_console_data_masking_policy_v1.example
+  id = "mask-pii" # Import by data masking policy name
+}
@@ -0,0 +1,9 @@
+resource "conduktor_console_data_masking_policy_v1" "simple" {
+  name = "mask-emails"
+  spec = {
+    fields = ["email"]
+    rule = {
+      type = "MASK_ALL"
+    }
+  }
+}
@@ -0,0 +1,35 @@
+variable "confluent_api_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "kafka_sasl_jaas_config" {
+  type      = string
+  sensitive = true
+}
+
+resource "conduktor_console_kafka_cluster_v2" "write_only" {
+  name = "write-only-cluster"
+  spec = {
+    display_name      = "Write-only secrets Cluster"
+    bootstrap_servers = "aaa-aaaa.us-west4.gcp.confluent.cloud:9092"
+    properties = {
+      "security.protocol" = "SASL_SSL"
+      "sasl.mechanism"    = "PLAIN"
+    }
+    kafka_flavor = {
+      confluent = {
+        key                      = "yourApiKey123456"
+        confluent_environment_id = "env-12345"
+        confluent_cluster_id     = "lkc-67890"
+      }
+    }
+  }
+
+  confluent_secret_wo         = var.confluent_api_secret
+  confluent_secret_wo_version = 1
+  properties_wo = {
+    "sasl.jaas.config" = var.kafka_sasl_jaas_config
+  }
+  properties_wo_version = 1
+}
@@ -0,0 +1,29 @@
+variable "connect_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "conduktor_console_kafka_cluster_v2" "minimal" {
+  name = "mini-cluster"
+  spec = {
+    display_name      = "Minimal Cluster"
+    bootstrap_servers = "localhost:9092"
+  }
+}
+
+resource "conduktor_console_kafka_connect_v2" "write_only" {
+  name    = "write-only-connect"
+  cluster = conduktor_console_kafka_cluster_v2.minimal.name
+  spec = {
+    display_name = "Write-only secret Connect server"
+    urls         = "http://localhost:8083"
+    security = {
+      basic_auth = {
+        username = "user"
+      }
+    }
+  }
+
+  security_password_wo         = var.connect_password
+  security_password_wo_version = 1
+}
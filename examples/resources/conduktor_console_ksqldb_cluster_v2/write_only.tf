@@ -0,0 +1,19 @@
+variable "ksqldb_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "conduktor_console_ksqldb_cluster_v2" "write_only" {
+  name    = "write-only-ksqldb"
+  cluster = "kafka-cluster"
+  spec = {
+    display_name = "Write-only secret KSQLDB cluster"
+    url          = "http://localhost:8088"
+    security = {
+      bearer_token = {}
+    }
+  }
+
+  security_token_wo         = var.ksqldb_token
+  security_token_wo_version = 1
+}
@@ -0,0 +1,4 @@
+import {
+  to = conduktor_gateway_alias_topic_v2.example
+  id = "alias-name/vcluster_sa" # Import <alias_topic_name>/<vcluster> Alias topic
+}
@@ -0,0 +1,9 @@
+resource "conduktor_gateway_alias_topic_v2" "checked" {
+  name     = "payments"
+  vcluster = "vcluster_sa"
+  # Console cluster backing the Gateway, used to check physical_name exists on plan
+  physical_cluster = "kafka-cluster"
+  spec = {
+    physical_name = "prod.payments.v1"
+  }
+}
@@ -0,0 +1,6 @@
+resource "conduktor_gateway_alias_topic_v2" "simple" {
+  name = "orders"
+  spec = {
+    physical_name = "prod.orders.v2"
+  }
+}
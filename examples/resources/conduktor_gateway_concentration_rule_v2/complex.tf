@@ -0,0 +1,14 @@
+resource "conduktor_gateway_concentration_rule_v2" "complex" {
+  name     = "complex-concentration-rule"
+  vcluster = "vcluster_sa"
+  spec = {
+    pattern = "low-throughput\\..*"
+    physical_topics = {
+      delete         = "concentrated-delete"
+      compact        = "concentrated-compact"
+      delete_compact = "concentrated-delete-compact"
+    }
+    auto_managed       = true
+    offset_correctness = true
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = conduktor_gateway_concentration_rule_v2.example
+  id = "vcluster_sa/rule-name" # Import <vcluster>/<concentration_rule_name> Concentration rule
+}
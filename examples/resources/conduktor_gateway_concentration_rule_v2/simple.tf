@@ -0,0 +1,9 @@
+resource "conduktor_gateway_concentration_rule_v2" "simple" {
+  name = "simple-concentration-rule"
+  spec = {
+    pattern = "events-.*"
+    physical_topics = {
+      delete = "concentrated-events"
+    }
+  }
+}
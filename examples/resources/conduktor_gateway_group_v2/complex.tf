@@ -0,0 +1,25 @@
+resource "conduktor_gateway_service_account_v2" "complex_member" {
+  name     = "complex-group-member"
+  vcluster = "vcluster_sa"
+  spec = {
+    type           = "EXTERNAL"
+    external_names = ["externalName"]
+  }
+}
+
+resource "conduktor_gateway_group_v2" "complex" {
+  name = "complex-group"
+  spec = {
+    members = [
+      {
+        vcluster = conduktor_gateway_service_account_v2.complex_member.vcluster
+        name     = conduktor_gateway_service_account_v2.complex_member.name
+      },
+      {
+        vcluster = "vcluster_sa"
+        name     = "other-service-account"
+      }
+    ]
+    external_groups = ["ldap-group"]
+  }
+}
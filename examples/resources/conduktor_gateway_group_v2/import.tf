@@ -0,0 +1,4 @@
+import {
+  to = conduktor_gateway_group_v2.example
+  id = "group-name" # Import <group_name> Group
+}
@@ -0,0 +1,17 @@
+resource "conduktor_gateway_service_account_v2" "simple_member" {
+  name = "simple-group-member"
+  spec = {
+    type = "LOCAL"
+  }
+}
+
+resource "conduktor_gateway_group_v2" "simple" {
+  name = "simple-group"
+  spec = {
+    members = [
+      {
+        name = conduktor_gateway_service_account_v2.simple_member.name
+      }
+    ]
+  }
+}
@@ -0,0 +1,11 @@
+resource "conduktor_gateway_token_v2" "rotation" {
+  vcluster         = "vcluster_sa"
+  username         = "user10"
+  lifetime_seconds = 604800 # 7 days
+  # A new token is planned on the first apply happening less than a day before the current one expires
+  rotate_before = "24h"
+}
+
+output "token_expires_at" {
+  value = conduktor_gateway_token_v2.rotation.expires_at
+}
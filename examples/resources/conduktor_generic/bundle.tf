@@ -0,0 +1,6 @@
+resource "conduktor_generic" "bundle" {
+  kind     = "Group"
+  version  = "v2"
+  name     = "sales"
+  manifest = file("sales_bundle.yaml")
+}
@@ -0,0 +1,16 @@
+resource "conduktor_generic" "gateway" {
+  kind    = "AliasTopic"
+  version = "v2"
+  name    = "orders-alias"
+  manifest = yamlencode({
+    apiVersion = "gateway/v2"
+    kind       = "AliasTopic"
+    metadata = {
+      name     = "orders-alias"
+      vCluster = "vcluster-sales"
+    }
+    spec = {
+      physicalName = "orders"
+    }
+  })
+}
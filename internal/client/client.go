@@ -37,6 +37,13 @@ type Client struct {
 	BaseUrl    string
 	Client     *resty.Client
 	AuthMethod AuthMethod
+	// RequestTimeout bounds each API request attempt, no bound other than the caller context if zero.
+	RequestTimeout time.Duration
+	RetryPolicy    RetryPolicy
+	// session renews the Console access token when logged in with username and password, nil otherwise.
+	session *consoleSession
+	// redactor masks the credentials of the logged request and response bodies.
+	redactor *Redactor
 }
 
 type LoginResult struct {
@@ -56,7 +63,22 @@ func Make(ctx context.Context, mode Mode, apiParameter ApiParameter, providerVer
 	if mode == CONSOLE {
 		apiParameter.BaseUrl = uniformizeBaseUrl(apiParameter.BaseUrl)
 	}
-	var err error
+
+	redactor, err := NewRedactor(apiParameter.LogRedactPatterns)
+	if err != nil {
+		return nil, err
+	}
+	// The http client debug logs are redacted too, including the login requests.
+	restyClient.OnRequestLog(func(log *resty.RequestLog) error {
+		log.Header = redactor.RedactHeaders(log.Header)
+		log.Body = redactor.Redact([]byte(log.Body))
+		return nil
+	})
+	restyClient.OnResponseLog(func(log *resty.ResponseLog) error {
+		log.Header = redactor.RedactHeaders(log.Header)
+		log.Body = redactor.Redact([]byte(log.Body))
+		return nil
+	})
 
 	// Enable http client debug logs when TF_LOG_PROVIDER_CONDUKTOR_INIT is set to trace
 	restyClient.SetDebug(InitTraceEnabled())
@@ -66,11 +88,20 @@ func Make(ctx context.Context, mode Mode, apiParameter ApiParameter, providerVer
 		return nil, err
 	}
 
-	restyClient, err = ConfigureAuth(mode, restyClient, apiParameter)
+	restyClient, err = ConfigureAuth(ctx, mode, restyClient, apiParameter)
 	if err != nil {
 		return nil, err
 	}
 
+	var session *consoleSession
+	if mode == CONSOLE && apiParameter.ApiKey == "" {
+		// Only Login with username and password if no apiKey has been provided.
+		session, err = newConsoleSession(ctx, restyClient, apiParameter)
+		if err != nil {
+			return nil, fmt.Errorf("could not login: %s", err)
+		}
+	}
+
 	// Enable http client debug logs when provider log is set to TRACE
 	restyClient.SetDebug(TraceLogEnabled())
 
@@ -80,28 +111,76 @@ func Make(ctx context.Context, mode Mode, apiParameter ApiParameter, providerVer
 	}
 
 	return &Client{
-		BaseUrl:    apiParameter.BaseUrl,
-		Client:     restyClient,
-		AuthMethod: authMethod,
+		BaseUrl:        apiParameter.BaseUrl,
+		Client:         restyClient,
+		AuthMethod:     authMethod,
+		RequestTimeout: apiParameter.RequestTimeout,
+		RetryPolicy:    apiParameter.RetryPolicy,
+		session:        session,
+		redactor:       redactor,
 	}, nil
 }
 
-func ConfigureAuth(mode Mode, restyClient *resty.Client, apiParameter ApiParameter) (*resty.Client, error) {
-	var err error
+// Execute sends a request built by build, bound to ctx so that Terraform cancellation and resource timeouts
+// interrupt it. The provider request timeout is applied on each attempt, and idempotent requests are retried
+// on transient errors according to the provider retry policy.
+// When logged in with username and password, the access token is renewed before it expires, and a request
+// rejected with a 401 is sent once more after renewing it.
+func (client *Client) Execute(ctx context.Context, method, url string, build func(*resty.Request)) (*resty.Response, error) {
+	if client.session == nil {
+		return executeWithRetry(ctx, client.Client, client.RetryPolicy, client.RequestTimeout, isIdempotent(method), method, url, build)
+	}
+
+	token, err := client.session.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := executeWithRetry(ctx, client.Client, client.RetryPolicy, client.RequestTimeout, isIdempotent(method), method, url, withAuthToken(token, build))
+	if err != nil || resp.StatusCode() != 401 {
+		return resp, err
+	}
+
+	token, err = client.session.renew(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return executeWithRetry(ctx, client.Client, client.RetryPolicy, client.RequestTimeout, isIdempotent(method), method, url, withAuthToken(token, build))
+}
+
+func withAuthToken(token string, build func(*resty.Request)) func(*resty.Request) {
+	return func(req *resty.Request) {
+		req.SetAuthToken(token)
+		if build != nil {
+			build(req)
+		}
+	}
+}
+
+// RedactBody returns the body with its credentials masked, for the requests sent with Execute and logged by the
+// caller.
+func (client *Client) RedactBody(body []byte) string {
+	return client.redactor.Redact(body)
+}
+
+// newRequest creates a request bound to ctx with the request timeout applied on top of it.
+// The returned cancel function must be called once the response has been read.
+func newRequest(ctx context.Context, restyClient *resty.Client, timeout time.Duration) (*resty.Request, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	return restyClient.R().SetContext(ctx), cancel
+}
+
+func ConfigureAuth(ctx context.Context, mode Mode, restyClient *resty.Client, apiParameter ApiParameter) (*resty.Client, error) {
 	switch mode {
 	case CONSOLE:
 		{
-			apiKey := apiParameter.ApiKey
-			if apiKey == "" {
-				// Only Login with username and password if no apiKey has been provided.
-				apiKey, err = Login(apiParameter, restyClient)
-				if err != nil {
-					return nil, fmt.Errorf("could not login: %s", err)
-				}
-			}
-
 			restyClient = restyClient.SetAuthScheme("Bearer")
-			restyClient = restyClient.SetAuthToken(apiKey)
+			// Without apiKey, the access token obtained by login is set on each request by the Client session.
+			if apiParameter.ApiKey != "" {
+				restyClient = restyClient.SetAuthToken(apiParameter.ApiKey)
+			}
 		}
 	case GATEWAY:
 		{
@@ -109,9 +188,9 @@ func ConfigureAuth(mode Mode, restyClient *resty.Client, apiParameter ApiParamet
 			restyClient.SetBasicAuth(apiParameter.CdkUser, apiParameter.CdkPassword)
 
 			// Testing authentication parameters against /metrics API.
-			// Returning error after 3 retries.
+			// Returning error once retries are exhausted.
 			testUrl := apiParameter.BaseUrl + "/metrics"
-			resp, err := restyClient.SetRetryCount(3).SetRetryWaitTime(1 * time.Second).R().Get(testUrl)
+			resp, err := executeWithRetry(ctx, restyClient, apiParameter.RetryPolicy, apiParameter.RequestTimeout, true, resty.MethodGet, testUrl, nil)
 			if err != nil {
 				return nil, err
 			} else if resp.IsError() {
@@ -132,30 +211,33 @@ func ConfigureAuth(mode Mode, restyClient *resty.Client, apiParameter ApiParamet
 	return restyClient, nil
 }
 
-// Helper function for Console Auth flow to retrieve access token.
-func Login(apiParameter ApiParameter, client *resty.Client) (string, error) {
+// Helper function for Console Auth flow to retrieve access and refresh tokens.
+func Login(ctx context.Context, apiParameter ApiParameter, client *resty.Client) (LoginResult, error) {
 	url := apiParameter.BaseUrl + "/login"
 	body := map[string]string{
 		"username": apiParameter.CdkUser,
 		"password": apiParameter.CdkPassword,
 	}
 
-	resp, err := client.SetRetryCount(3).SetRetryWaitTime(1 * time.Second).R().SetBody(body).Post(url)
+	// Like any POST, login is not retried, so that a failing login doesn't count several times against lockout policies.
+	resp, err := executeWithRetry(ctx, client, apiParameter.RetryPolicy, apiParameter.RequestTimeout, isIdempotent(resty.MethodPost), resty.MethodPost, url, func(req *resty.Request) {
+		req.SetBody(body)
+	})
 	if err != nil {
-		return "", err
+		return LoginResult{}, err
 	} else if resp.IsError() {
 		if resp.StatusCode() == 401 {
-			return "", fmt.Errorf("invalid username or password")
+			return LoginResult{}, fmt.Errorf("invalid username or password")
 		} else {
-			return "", fmt.Errorf("%s", ExtractApiError(resp))
+			return LoginResult{}, fmt.Errorf("%s", ExtractApiError(resp))
 		}
 	}
 	result := LoginResult{}
 	err = jsoniter.Unmarshal(resp.Body(), &result)
 	if err != nil {
-		return "", err
+		return LoginResult{}, err
 	}
-	return result.AccessToken, nil
+	return result, nil
 }
 
 func ConfigureTLS(ctx context.Context, restyClient *resty.Client, tlsParameter TLSParameters) (*resty.Client, error) {
@@ -183,9 +265,16 @@ func ConfigureTLS(ctx context.Context, restyClient *resty.Client, tlsParameter T
 	return restyClient, nil
 }
 
-func (client *Client) ApplyGeneric(ctx context.Context, cliResource ctlresource.Resource) (string, error) {
-	catalog := ctlschema.ConsoleDefaultCatalog() // TODO support gateway kind and client too
-	kinds := catalog.Kind
+// DefaultKindCatalog returns the CLI default catalog of the kinds served by the API of the given mode.
+func DefaultKindCatalog(mode Mode) ctlschema.KindCatalog {
+	if mode == GATEWAY {
+		return ctlschema.GatewayDefaultCatalog().Kind
+	}
+	return ctlschema.ConsoleDefaultCatalog().Kind
+}
+
+func (client *Client) ApplyGeneric(ctx context.Context, mode Mode, cliResource ctlresource.Resource) (string, error) {
+	kinds := DefaultKindCatalog(mode)
 	kindName := cliResource.Kind
 	kind, ok := kinds[kindName]
 	if !ok {
@@ -199,14 +288,14 @@ func (client *Client) ApplyGeneric(ctx context.Context, cliResource ctlresource.
 
 	url := client.BaseUrl + applyPath.Path
 
-	builder := client.Client.R().SetBody(cliResource.Json)
-	// Required query params for kinds scoped by metadata, e.g. Alert v3 (#186).
-	for _, queryParam := range applyPath.QueryParams {
-		builder = builder.SetQueryParam(queryParam.Name, queryParam.Value)
-	}
-
-	tflog.Trace(ctx, fmt.Sprintf("PUT on %s body : %s", url, string(cliResource.Json)))
-	resp, err := builder.Put(url)
+	tflog.Trace(ctx, fmt.Sprintf("PUT on %s body : %s", url, client.redactor.Redact(cliResource.Json)))
+	resp, err := client.Execute(ctx, resty.MethodPut, url, func(builder *resty.Request) {
+		builder.SetBody(cliResource.Json)
+		// Required query params for kinds scoped by metadata, e.g. Alert v3 (#186).
+		for _, queryParam := range applyPath.QueryParams {
+			builder.SetQueryParam(queryParam.Name, queryParam.Value)
+		}
+	})
 	if err != nil {
 		return "", err
 	} else if resp.IsError() {
@@ -222,15 +311,30 @@ func (client *Client) ApplyGeneric(ctx context.Context, cliResource ctlresource.
 }
 
 func (client *Client) Apply(ctx context.Context, path string, resource any) (ApplyResult, error) {
+	return client.apply(ctx, path, resource, false)
+}
+
+// DryRunApply validates the resource against the Console apply endpoint in dry-run mode, so that it is checked
+// like on a real apply (policies, ownership...) without being changed.
+func (client *Client) DryRunApply(ctx context.Context, path string, resource any) (ApplyResult, error) {
+	return client.apply(ctx, path, resource, true)
+}
+
+func (client *Client) apply(ctx context.Context, path string, resource any, dryMode bool) (ApplyResult, error) {
 	url := client.BaseUrl + path
 	jsonData, err := jsoniter.Marshal(resource)
 	if err != nil {
 		return ApplyResult{}, fmt.Errorf("error marshalling resource: %s", err)
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("PUT %s request body : %s", path, string(jsonData)))
+	tflog.Trace(ctx, fmt.Sprintf("PUT %s request body (dry mode: %t) : %s", path, dryMode, client.redactor.Redact(jsonData)))
 
-	resp, err := client.Client.R().SetBody(jsonData).Put(url)
+	resp, err := client.Execute(ctx, resty.MethodPut, url, func(req *resty.Request) {
+		req.SetBody(jsonData)
+		if dryMode {
+			req.SetQueryParam("dryMode", "true")
+		}
+	})
 	if err != nil {
 		return ApplyResult{}, err
 	} else if resp.IsError() {
@@ -238,7 +342,7 @@ func (client *Client) Apply(ctx context.Context, path string, resource any) (App
 	}
 
 	bodyBytes := resp.Body()
-	tflog.Trace(ctx, fmt.Sprintf("PUT %s response body : %s", path, string(bodyBytes)))
+	tflog.Trace(ctx, fmt.Sprintf("PUT %s response body : %s", path, client.redactor.Redact(bodyBytes)))
 
 	var upsertResponse ApplyResult
 	err = jsoniter.Unmarshal(bodyBytes, &upsertResponse)
@@ -250,7 +354,7 @@ func (client *Client) Apply(ctx context.Context, path string, resource any) (App
 
 func (client *Client) Describe(ctx context.Context, path string) ([]byte, error) {
 	url := client.BaseUrl + path
-	resp, err := client.Client.R().Get(url)
+	resp, err := client.Execute(ctx, resty.MethodGet, url, nil)
 	if err != nil {
 		return []byte{}, err
 	} else if resp.IsError() {
@@ -259,18 +363,32 @@ func (client *Client) Describe(ctx context.Context, path string) ([]byte, error)
 		}
 		return []byte{}, fmt.Errorf("error describing resources %s, got status code: %d:\n %s", path, resp.StatusCode(), string(resp.Body()))
 	}
-	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", path, string(resp.Body())))
+	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", path, client.redactor.Redact(resp.Body())))
+	return resp.Body(), nil
+}
+
+// Post sends an action to the API, like pausing a connector, and returns the response body.
+// The request is not retried, as actions are not idempotent.
+func (client *Client) Post(ctx context.Context, path string) ([]byte, error) {
+	url := client.BaseUrl + path
+	tflog.Trace(ctx, fmt.Sprintf("POST %s", path))
+
+	resp, err := client.Execute(ctx, resty.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("%s", ExtractApiError(resp))
+	}
+	tflog.Trace(ctx, fmt.Sprintf("POST %s response : %s", path, client.redactor.Redact(resp.Body())))
 	return resp.Body(), nil
 }
 
 func (client *Client) Delete(ctx context.Context, mode Mode, path string, resource any) error {
-	var req *resty.Request
 	url := client.BaseUrl + path
 	tflog.Trace(ctx, fmt.Sprintf("DELETE %s", path))
 
+	var body string
 	switch mode {
-	case CONSOLE:
-		req = client.Client.R()
 	case GATEWAY:
 		// Gateway API handles deletion in a different way
 		// It needs information about the resource in the body of the request
@@ -279,13 +397,16 @@ func (client *Client) Delete(ctx context.Context, mode Mode, path string, resour
 		if err != nil {
 			return fmt.Errorf("error marshalling resource: %s", err)
 		}
-		tflog.Debug(ctx, string(jsonData))
-		tflog.Trace(ctx, fmt.Sprintf("DELETE %s request body : %s", path, string(jsonData)))
+		tflog.Trace(ctx, fmt.Sprintf("DELETE %s request body : %s", path, client.redactor.Redact(jsonData)))
 
-		req = client.Client.R().SetBody(string(jsonData))
+		body = string(jsonData)
 	}
 
-	resp, err := req.Delete(url)
+	resp, err := client.Execute(ctx, resty.MethodDelete, url, func(req *resty.Request) {
+		if body != "" {
+			req.SetBody(body)
+		}
+	})
 	if err != nil {
 		return err
 	} else if resp.IsError() {
@@ -305,13 +426,13 @@ func (client *Client) GetAPIVersion(ctx context.Context, mode Mode) (string, err
 	}
 
 	url := client.BaseUrl + path
-	resp, err := client.Client.R().Get(url)
+	resp, err := client.Execute(ctx, resty.MethodGet, url, nil)
 	if err != nil {
 		return "", err
 	} else if resp.IsError() {
 		return "", fmt.Errorf("%s", ExtractApiError(resp))
 	}
-	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", path, string(resp.Body())))
+	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", path, client.redactor.Redact(resp.Body())))
 
 	var result map[string]any
 	err = json.Unmarshal(resp.Body(), &result)
@@ -367,13 +488,13 @@ func (client *Client) GetAPIVersion(ctx context.Context, mode Mode) (string, err
 func (client *Client) GetConsoleLicensePlan(ctx context.Context) (string, error) {
 	// Get the default organization slug
 	orgsURL := client.BaseUrl + "/organizations"
-	orgsResp, err := client.Client.R().Get(orgsURL)
+	orgsResp, err := client.Execute(ctx, resty.MethodGet, orgsURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("error fetching organizations: %s", err)
 	} else if orgsResp.IsError() {
 		return "", fmt.Errorf("error fetching organizations: %s", ExtractApiError(orgsResp))
 	}
-	tflog.Trace(ctx, fmt.Sprintf("GET /api/organizations response : %s", string(orgsResp.Body())))
+	tflog.Trace(ctx, fmt.Sprintf("GET /api/organizations response : %s", client.redactor.Redact(orgsResp.Body())))
 
 	var orgs []map[string]any
 	err = json.Unmarshal(orgsResp.Body(), &orgs)
@@ -392,13 +513,13 @@ func (client *Client) GetConsoleLicensePlan(ctx context.Context) (string, error)
 	// Fetch the license info for the organization
 	licensePath := fmt.Sprintf("/organizations/%s/platform-license", slug)
 	licenseURL := client.BaseUrl + licensePath
-	licenseResp, err := client.Client.R().Get(licenseURL)
+	licenseResp, err := client.Execute(ctx, resty.MethodGet, licenseURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("error fetching license info: %s", err)
 	} else if licenseResp.IsError() {
 		return "", fmt.Errorf("error fetching license info: %s", ExtractApiError(licenseResp))
 	}
-	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", licensePath, string(licenseResp.Body())))
+	tflog.Trace(ctx, fmt.Sprintf("GET %s response : %s", licensePath, client.redactor.Redact(licenseResp.Body())))
 
 	var licenseInfo map[string]any
 	err = json.Unmarshal(licenseResp.Body(), &licenseInfo)
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"os"
+	"strings"
+	"time"
+
 	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/provider_conduktor"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type ApiParameter struct {
@@ -11,6 +16,11 @@ type ApiParameter struct {
 	CdkUser       string
 	CdkPassword   string
 	TLSParameters TLSParameters
+	// RequestTimeout is parsed from request_timeout by the provider, zero means no timeout.
+	RequestTimeout time.Duration
+	RetryPolicy    RetryPolicy
+	// LogRedactPatterns extend the default patterns of the keys masked in logged bodies.
+	LogRedactPatterns []string
 }
 
 type TLSParameters struct {
@@ -20,30 +30,142 @@ type TLSParameters struct {
 	Insecure bool
 }
 
+// connectionConfig holds the connection attributes of a client, set either at the root of the provider
+// configuration along with `mode`, or in its `console` or `gateway` block.
+type connectionConfig struct {
+	BaseUrl       basetypes.StringValue
+	ApiToken      basetypes.StringValue
+	AdminUser     basetypes.StringValue
+	AdminPassword basetypes.StringValue
+	Cacert        basetypes.StringValue
+	Cert          basetypes.StringValue
+	Key           basetypes.StringValue
+	Insecure      basetypes.BoolValue
+}
+
+// LoadConfig loads the parameters of the client of the given mode, from the `console` or `gateway` block when set,
+// from the root attributes otherwise, falling back to the environment variables of that mode.
 func LoadConfig(providerInputConfig schema.ConduktorModel, mode Mode) ApiParameter {
 	var apiParameter ApiParameter
 
+	connection := connectionConfig{
+		BaseUrl:       providerInputConfig.BaseUrl,
+		ApiToken:      providerInputConfig.ApiToken,
+		AdminUser:     providerInputConfig.AdminUser,
+		AdminPassword: providerInputConfig.AdminPassword,
+		Cacert:        providerInputConfig.Cacert,
+		Cert:          providerInputConfig.Cert,
+		Key:           providerInputConfig.Key,
+		Insecure:      providerInputConfig.Insecure,
+	}
+
 	switch mode {
 	case CONSOLE:
-		apiParameter.BaseUrl = schemaUtils.GetStringConfig(providerInputConfig.BaseUrl, []string{"CDK_CONSOLE_BASE_URL", "CDK_BASE_URL"})
-		apiParameter.ApiKey = schemaUtils.GetStringConfig(providerInputConfig.ApiToken, []string{"CDK_API_TOKEN", "CDK_API_KEY"})
-		apiParameter.CdkUser = schemaUtils.GetStringConfig(providerInputConfig.AdminUser, []string{"CDK_CONSOLE_USER", "CDK_ADMIN_EMAIL", "CDK_ADMIN_USER"})
-		apiParameter.CdkPassword = schemaUtils.GetStringConfig(providerInputConfig.AdminPassword, []string{"CDK_CONSOLE_PASSWORD", "CDK_ADMIN_PASSWORD"})
-		apiParameter.TLSParameters.Cert = schemaUtils.GetStringConfig(providerInputConfig.Cert, []string{"CDK_CONSOLE_CERT", "CDK_CERT"})
-		apiParameter.TLSParameters.Cacert = schemaUtils.GetStringConfig(providerInputConfig.Cacert, []string{"CDK_CONSOLE_CACERT", "CDK_CACERT"})
-		apiParameter.TLSParameters.Key = schemaUtils.GetStringConfig(providerInputConfig.Key, []string{"CDK_CONSOLE_KEY", "CDK_KEY"})
-		apiParameter.TLSParameters.Insecure = schemaUtils.GetBooleanConfig(providerInputConfig.Insecure, []string{"CDK_CONSOLE_INSECURE", "CDK_INSECURE"}, false)
+		if schemaUtils.AttrIsSet(providerInputConfig.Console) {
+			block := providerInputConfig.Console
+			connection = connectionConfig{
+				BaseUrl:       block.BaseUrl,
+				ApiToken:      block.ApiToken,
+				AdminUser:     block.AdminUser,
+				AdminPassword: block.AdminPassword,
+				Cacert:        block.Cacert,
+				Cert:          block.Cert,
+				Key:           block.Key,
+				Insecure:      block.Insecure,
+			}
+		}
+
+		apiParameter.BaseUrl = schemaUtils.GetStringConfig(connection.BaseUrl, []string{"CDK_CONSOLE_BASE_URL", "CDK_BASE_URL"})
+		apiParameter.ApiKey = schemaUtils.GetStringConfig(connection.ApiToken, []string{"CDK_API_TOKEN", "CDK_API_KEY"})
+		apiParameter.CdkUser = schemaUtils.GetStringConfig(connection.AdminUser, []string{"CDK_CONSOLE_USER", "CDK_ADMIN_EMAIL", "CDK_ADMIN_USER"})
+		apiParameter.CdkPassword = schemaUtils.GetStringConfig(connection.AdminPassword, []string{"CDK_CONSOLE_PASSWORD", "CDK_ADMIN_PASSWORD"})
+		apiParameter.TLSParameters.Cert = schemaUtils.GetStringConfig(connection.Cert, []string{"CDK_CONSOLE_CERT", "CDK_CERT"})
+		apiParameter.TLSParameters.Cacert = schemaUtils.GetStringConfig(connection.Cacert, []string{"CDK_CONSOLE_CACERT", "CDK_CACERT"})
+		apiParameter.TLSParameters.Key = schemaUtils.GetStringConfig(connection.Key, []string{"CDK_CONSOLE_KEY", "CDK_KEY"})
+		apiParameter.TLSParameters.Insecure = schemaUtils.GetBooleanConfig(connection.Insecure, []string{"CDK_CONSOLE_INSECURE", "CDK_INSECURE"}, false)
 
 	case GATEWAY:
-		apiParameter.BaseUrl = schemaUtils.GetStringConfig(providerInputConfig.BaseUrl, []string{"CDK_GATEWAY_BASE_URL", "CDK_BASE_URL"})
-		apiParameter.CdkUser = schemaUtils.GetStringConfig(providerInputConfig.AdminUser, []string{"CDK_GATEWAY_USER", "CDK_ADMIN_USER"})
-		apiParameter.CdkPassword = schemaUtils.GetStringConfig(providerInputConfig.AdminPassword, []string{"CDK_GATEWAY_PASSWORD", "CDK_ADMIN_PASSWORD"})
-		apiParameter.TLSParameters.Cert = schemaUtils.GetStringConfig(providerInputConfig.Cert, []string{"CDK_GATEWAY_CERT", "CDK_CERT"})
-		apiParameter.TLSParameters.Cacert = schemaUtils.GetStringConfig(providerInputConfig.Cacert, []string{"CDK_GATEWAY_CACERT", "CDK_CACERT"})
-		apiParameter.TLSParameters.Key = schemaUtils.GetStringConfig(providerInputConfig.Key, []string{"CDK_GATEWAY_KEY", "CDK_KEY"})
-		apiParameter.TLSParameters.Insecure = schemaUtils.GetBooleanConfig(providerInputConfig.Insecure, []string{"CDK_GATEWAY_INSECURE", "CDK_INSECURE"}, false)
+		if schemaUtils.AttrIsSet(providerInputConfig.Gateway) {
+			block := providerInputConfig.Gateway
+			connection = connectionConfig{
+				BaseUrl:       block.BaseUrl,
+				AdminUser:     block.AdminUser,
+				AdminPassword: block.AdminPassword,
+				Cacert:        block.Cacert,
+				Cert:          block.Cert,
+				Key:           block.Key,
+				Insecure:      block.Insecure,
+			}
+		}
+
+		apiParameter.BaseUrl = schemaUtils.GetStringConfig(connection.BaseUrl, []string{"CDK_GATEWAY_BASE_URL", "CDK_BASE_URL"})
+		apiParameter.CdkUser = schemaUtils.GetStringConfig(connection.AdminUser, []string{"CDK_GATEWAY_USER", "CDK_ADMIN_USER"})
+		apiParameter.CdkPassword = schemaUtils.GetStringConfig(connection.AdminPassword, []string{"CDK_GATEWAY_PASSWORD", "CDK_ADMIN_PASSWORD"})
+		apiParameter.TLSParameters.Cert = schemaUtils.GetStringConfig(connection.Cert, []string{"CDK_GATEWAY_CERT", "CDK_CERT"})
+		apiParameter.TLSParameters.Cacert = schemaUtils.GetStringConfig(connection.Cacert, []string{"CDK_GATEWAY_CACERT", "CDK_CACERT"})
+		apiParameter.TLSParameters.Key = schemaUtils.GetStringConfig(connection.Key, []string{"CDK_GATEWAY_KEY", "CDK_KEY"})
+		apiParameter.TLSParameters.Insecure = schemaUtils.GetBooleanConfig(connection.Insecure, []string{"CDK_GATEWAY_INSECURE", "CDK_INSECURE"}, false)
 
 	}
 
+	apiParameter.RetryPolicy = loadRetryPolicy(providerInputConfig.Retry)
+	apiParameter.LogRedactPatterns = loadLogRedactPatterns(providerInputConfig.LogRedactPatterns)
+
 	return apiParameter
 }
+
+// loadRetryPolicy overrides the default retry policy with the values set in the provider retry block.
+// Durations are checked by the schema validators, so parsing errors can't happen here.
+func loadRetryPolicy(retry schema.RetryValue) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	if !schemaUtils.AttrIsSet(retry) {
+		return policy
+	}
+
+	if schemaUtils.AttrIsSet(retry.MaxAttempts) {
+		policy.MaxAttempts = int(retry.MaxAttempts.ValueInt64())
+	}
+	if schemaUtils.AttrIsSet(retry.BaseBackoff) {
+		if d, err := time.ParseDuration(retry.BaseBackoff.ValueString()); err == nil {
+			policy.BaseBackoff = d
+		}
+	}
+	if schemaUtils.AttrIsSet(retry.MaxBackoff) {
+		if d, err := time.ParseDuration(retry.MaxBackoff.ValueString()); err == nil {
+			policy.MaxBackoff = d
+		}
+	}
+	if schemaUtils.AttrIsSet(retry.Jitter) {
+		policy.Jitter = retry.Jitter.ValueBool()
+	}
+	if schemaUtils.AttrIsSet(retry.RetryableStatusCodes) {
+		policy.RetryableStatusCodes = []int{}
+		for _, element := range retry.RetryableStatusCodes.Elements() {
+			if code, ok := element.(basetypes.Int64Value); ok && !code.IsNull() && !code.IsUnknown() {
+				policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code.ValueInt64()))
+			}
+		}
+	}
+	return policy
+}
+
+// loadLogRedactPatterns returns the patterns of the provider log_redact_patterns attribute, falling back to the
+// comma separated CDK_LOG_REDACT_PATTERNS environment variable.
+func loadLogRedactPatterns(patterns basetypes.ListValue) []string {
+	result := []string{}
+	if !schemaUtils.AttrIsSet(patterns) {
+		for _, pattern := range strings.Split(os.Getenv("CDK_LOG_REDACT_PATTERNS"), ",") {
+			if strings.TrimSpace(pattern) != "" {
+				result = append(result, strings.TrimSpace(pattern))
+			}
+		}
+		return result
+	}
+
+	for _, element := range patterns.Elements() {
+		if pattern, ok := element.(basetypes.StringValue); ok && !pattern.IsNull() && !pattern.IsUnknown() {
+			result = append(result, pattern.ValueString())
+		}
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/provider_conduktor"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLoadConfigFromBlocks(t *testing.T) {
+	ctx := context.Background()
+	for _, env := range []string{"CDK_BASE_URL", "CDK_CONSOLE_BASE_URL", "CDK_GATEWAY_BASE_URL", "CDK_API_TOKEN", "CDK_API_KEY", "CDK_ADMIN_USER", "CDK_GATEWAY_USER"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("CDK_GATEWAY_PASSWORD", "gateway-password-from-env")
+
+	input := schema.ConduktorModel{
+		Mode:     types.StringNull(),
+		BaseUrl:  types.StringValue("http://root:8080"),
+		ApiToken: types.StringValue("root-token"),
+		Console: schema.NewConsoleValueMust(schema.ConsoleValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"base_url":       types.StringValue("http://console:8080"),
+			"api_token":      types.StringValue("console-token"),
+			"admin_user":     types.StringNull(),
+			"admin_password": types.StringNull(),
+			"cacert":         types.StringNull(),
+			"cert":           types.StringNull(),
+			"key":            types.StringNull(),
+			"insecure":       types.BoolValue(true),
+		}),
+		Gateway: schema.NewGatewayValueMust(schema.GatewayValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"base_url":       types.StringValue("http://gateway:8888"),
+			"admin_user":     types.StringValue("admin"),
+			"admin_password": types.StringNull(),
+			"cacert":         types.StringNull(),
+			"cert":           types.StringNull(),
+			"key":            types.StringNull(),
+			"insecure":       types.BoolNull(),
+		}),
+		Retry: schema.NewRetryValueNull(),
+	}
+
+	console := LoadConfig(input, CONSOLE)
+	if console.BaseUrl != "http://console:8080" || console.ApiKey != "console-token" || !console.TLSParameters.Insecure {
+		t.Errorf("expected Console parameters from the console block, got %+v", console)
+	}
+
+	gateway := LoadConfig(input, GATEWAY)
+	if gateway.BaseUrl != "http://gateway:8888" || gateway.CdkUser != "admin" || gateway.TLSParameters.Insecure {
+		t.Errorf("expected Gateway parameters from the gateway block, got %+v", gateway)
+	}
+	if gateway.CdkPassword != "gateway-password-from-env" {
+		t.Errorf("expected Gateway password to fall back to its environment variable, got %q", gateway.CdkPassword)
+	}
+
+	input.Console = schema.NewConsoleValueNull()
+	flat := LoadConfig(input, CONSOLE)
+	if flat.BaseUrl != "http://root:8080" || flat.ApiKey != "root-token" {
+		t.Errorf("expected Console parameters from the root attributes without console block, got %+v", flat)
+	}
+}
@@ -3,9 +3,14 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
 )
 
 func TestGetConsoleLicensePlan_NoDoubleApiPrefix(t *testing.T) {
@@ -101,3 +106,147 @@ func TestUniformizeBaseUrl(t *testing.T) {
 		}
 	}
 }
+
+func TestDescribeHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulate a hung API call, only answering once the test is over.
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key"}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.Describe(ctx, "/public/kafka/v2/cluster/my-cluster/topic/my-topic")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context deadline exceeded error, got %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RequestTimeout: 50 * time.Millisecond}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	start := time.Now()
+	_, err = c.Apply(context.Background(), "/public/kafka/v2/cluster/my-cluster/topic", map[string]string{"kind": "Topic"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request was not interrupted by request timeout, took %s", elapsed)
+	}
+}
+
+func TestDryRunApply(t *testing.T) {
+	var dryModes []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dryModes = append(dryModes, r.URL.Query().Get("dryMode"))
+		if r.URL.Query().Get("dryMode") == "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"title": "Topic policy violation"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"upsertResult": "Created"})
+	}))
+	defer ts.Close()
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = c.DryRunApply(context.Background(), "/public/v1/resource", map[string]string{"kind": "Test"})
+	if err == nil {
+		t.Fatal("expected dry-run rejection to be returned as an error")
+	}
+	result, err := c.Apply(context.Background(), "/public/v1/resource", map[string]string{"kind": "Test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.UpsertResult != "Created" {
+		t.Errorf("expected upsert result 'Created', got %q", result.UpsertResult)
+	}
+	if len(dryModes) != 2 || dryModes[0] != "true" || dryModes[1] != "" {
+		t.Errorf("expected only the first request in dry mode, got %v", dryModes)
+	}
+}
+
+func TestPostIsNotRetried(t *testing.T) {
+	var hits []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits = append(hits, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]any{"title": "Kafka Connect unavailable"})
+	}))
+	defer ts.Close()
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = c.Post(context.Background(), "/public/kafka/v2/cluster/my-cluster/connect/my-connect/connector/my-connector/pause")
+	if err == nil {
+		t.Fatal("expected error response to be returned as an error")
+	}
+	if len(hits) != 1 || hits[0] != "POST /api/public/kafka/v2/cluster/my-cluster/connect/my-connect/connector/my-connector/pause" {
+		t.Errorf("expected a single POST request, got %v", hits)
+	}
+}
+
+func TestLoginIsNotRetried(t *testing.T) {
+	var hits int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	apiParameter := ApiParameter{BaseUrl: ts.URL, CdkUser: "admin", CdkPassword: "password", RetryPolicy: testRetryPolicy()}
+	_, err := Login(context.Background(), apiParameter, resty.New())
+	if err == nil {
+		t.Fatal("expected error response to be returned as an error")
+	}
+	if hits != 1 {
+		t.Errorf("expected a single login request, got %d", hits)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: "http://localhost", ApiKey: "test-key", LogRedactPatterns: []string{`^jwt$`}}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	redacted := c.RedactBody([]byte(`{"username":"user","token":"some-token","jwt":"some-jwt"}`))
+	for _, leaked := range []string{"some-token", "some-jwt"} {
+		if strings.Contains(redacted, leaked) {
+			t.Errorf("expected %q to be redacted, got %s", leaked, redacted)
+		}
+	}
+	if !strings.Contains(redacted, `"username":"user"`) {
+		t.Errorf("expected username to be kept, got %s", redacted)
+	}
+}
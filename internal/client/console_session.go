@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Access tokens are renewed this long before their expiry, so that requests sent right before it don't fail.
+const tokenRefreshMargin = 1 * time.Minute
+
+// consoleSession holds the tokens obtained by a Console login with username and password, and renews them when
+// they expire. It is shared by all the requests of a Client, so it is safe for concurrent use.
+type consoleSession struct {
+	mu           sync.Mutex
+	restyClient  *resty.Client
+	apiParameter ApiParameter
+	accessToken  string
+	refreshToken string
+	// expiresAt is zero when the login didn't tell when the access token expires.
+	expiresAt time.Time
+}
+
+func newConsoleSession(ctx context.Context, restyClient *resty.Client, apiParameter ApiParameter) (*consoleSession, error) {
+	result, err := Login(ctx, apiParameter, restyClient)
+	if err != nil {
+		return nil, err
+	}
+	session := &consoleSession{restyClient: restyClient, apiParameter: apiParameter}
+	session.update(result)
+	return session, nil
+}
+
+// token returns the current access token, renewing it first if it is about to expire.
+func (s *consoleSession) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.expiresAt.IsZero() && time.Until(s.expiresAt) < tokenRefreshMargin {
+		tflog.Debug(ctx, "Console access token is about to expire, renewing it")
+		if err := s.renewLocked(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.accessToken, nil
+}
+
+// renew renews the access token after it got rejected. Nothing is done if staleToken has already been replaced by
+// a concurrent request, the current access token is returned instead.
+func (s *consoleSession) renew(ctx context.Context, staleToken string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken == staleToken {
+		tflog.Debug(ctx, "Console access token rejected, renewing it")
+		if err := s.renewLocked(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.accessToken, nil
+}
+
+// renewLocked uses the refresh token if any, and falls back to a new login if the refresh fails.
+// Must be called with the session lock held.
+func (s *consoleSession) renewLocked(ctx context.Context) error {
+	if s.refreshToken != "" {
+		result, err := s.refresh(ctx)
+		if err == nil {
+			s.update(result)
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Could not refresh Console access token, logging in again: %s", err))
+	}
+
+	result, err := Login(ctx, s.apiParameter, s.restyClient)
+	if err != nil {
+		return fmt.Errorf("could not login: %s", err)
+	}
+	s.update(result)
+	return nil
+}
+
+func (s *consoleSession) refresh(ctx context.Context) (LoginResult, error) {
+	url := s.apiParameter.BaseUrl + "/login/refresh"
+	body := map[string]string{
+		"refresh_token": s.refreshToken,
+	}
+
+	resp, err := executeWithRetry(ctx, s.restyClient, s.apiParameter.RetryPolicy, s.apiParameter.RequestTimeout, false, resty.MethodPost, url, func(req *resty.Request) {
+		req.SetBody(body)
+	})
+	if err != nil {
+		return LoginResult{}, err
+	} else if resp.IsError() {
+		return LoginResult{}, fmt.Errorf("%s", ExtractApiError(resp))
+	}
+	result := LoginResult{}
+	err = jsoniter.Unmarshal(resp.Body(), &result)
+	if err != nil {
+		return LoginResult{}, err
+	}
+	if result.AccessToken == "" {
+		return LoginResult{}, fmt.Errorf("no access token in refresh response")
+	}
+	return result, nil
+}
+
+func (s *consoleSession) update(result LoginResult) {
+	s.accessToken = result.AccessToken
+	if result.RefreshToken != "" {
+		s.refreshToken = result.RefreshToken
+	}
+	s.expiresAt = time.Time{}
+	if result.ExpiresIn > 0 {
+		s.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// Fake Console server issuing numbered access tokens on login and refresh, and rejecting requests sent with any
+// token other than the last issued one.
+type fakeConsoleAuth struct {
+	mu           sync.Mutex
+	issued       int
+	expiresIn    int
+	refreshFails bool
+	logins       atomic.Int32
+	refreshes    atomic.Int32
+	calls        atomic.Int32
+}
+
+func (f *fakeConsoleAuth) issue(w http.ResponseWriter) {
+	f.mu.Lock()
+	f.issued++
+	result := LoginResult{
+		AccessToken:  fmt.Sprintf("access-%d", f.issued),
+		RefreshToken: fmt.Sprintf("refresh-%d", f.issued),
+		TokenType:    "Bearer",
+		ExpiresIn:    f.expiresIn,
+	}
+	f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeConsoleAuth) revoke() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.issued++
+}
+
+func (f *fakeConsoleAuth) server(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		f.logins.Add(1)
+		f.issue(w)
+	})
+	mux.HandleFunc("/api/login/refresh", func(w http.ResponseWriter, r *http.Request) {
+		f.refreshes.Add(1)
+		if f.refreshFails {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.issue(w)
+	})
+	mux.HandleFunc("/api/public/v1/resource", func(w http.ResponseWriter, r *http.Request) {
+		f.calls.Add(1)
+		f.mu.Lock()
+		valid := r.Header.Get("Authorization") == fmt.Sprintf("Bearer access-%d", f.issued)
+		f.mu.Unlock()
+		if !valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newCredentialsClient(t *testing.T, ts *httptest.Server) *Client {
+	t.Helper()
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, CdkUser: "admin", CdkPassword: "secret", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestConsoleSessionRefreshesBeforeExpiry(t *testing.T) {
+	// Access tokens expiring within the refresh margin are renewed before each request.
+	auth := &fakeConsoleAuth{expiresIn: 30}
+	c := newCredentialsClient(t, auth.server(t))
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Describe(context.Background(), "/public/v1/resource"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if auth.refreshes.Load() != 2 {
+		t.Errorf("expected 2 refreshes, got %d", auth.refreshes.Load())
+	}
+	if auth.logins.Load() != 1 {
+		t.Errorf("expected 1 login, got %d", auth.logins.Load())
+	}
+	if auth.calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", auth.calls.Load())
+	}
+}
+
+func TestConsoleSessionReplaysOnUnauthorized(t *testing.T) {
+	auth := &fakeConsoleAuth{expiresIn: 3600}
+	c := newCredentialsClient(t, auth.server(t))
+	auth.revoke()
+
+	if _, err := c.Apply(context.Background(), "/public/v1/resource", map[string]string{"kind": "Test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth.calls.Load() != 2 {
+		t.Errorf("expected request to be replayed once, got %d calls", auth.calls.Load())
+	}
+	if auth.refreshes.Load() != 1 {
+		t.Errorf("expected 1 refresh, got %d", auth.refreshes.Load())
+	}
+}
+
+func TestConsoleSessionFallsBackToLogin(t *testing.T) {
+	auth := &fakeConsoleAuth{expiresIn: 3600, refreshFails: true}
+	c := newCredentialsClient(t, auth.server(t))
+	auth.revoke()
+
+	if _, err := c.Describe(context.Background(), "/public/v1/resource"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auth.logins.Load() != 2 {
+		t.Errorf("expected a second login after refresh failure, got %d logins", auth.logins.Load())
+	}
+}
+
+func TestApiKeyIsNotRenewed(t *testing.T) {
+	auth := &fakeConsoleAuth{}
+	ts := auth.server(t)
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	resp, err := c.Execute(context.Background(), http.MethodGet, c.BaseUrl+"/public/v1/resource", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("expected status code 401, got %d", resp.StatusCode())
+	}
+	if auth.calls.Load() != 1 || auth.logins.Load() != 0 || auth.refreshes.Load() != 0 {
+		t.Errorf("expected a single call without login, got %d calls, %d logins, %d refreshes", auth.calls.Load(), auth.logins.Load(), auth.refreshes.Load())
+	}
+}
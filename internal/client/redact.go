@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Value logged in place of the redacted ones.
+const redactedValue = "***"
+
+// Default patterns of the keys holding credentials, matched case-insensitively on any key of a logged body so that
+// free-form maps like Kafka properties or connector configs are covered too.
+var DefaultLogRedactPatterns = []string{
+	`password`,
+	`secret`,
+	`token`,
+	`sasl\.jaas\.config`,
+	`private\.?key`,
+}
+
+// Paths of the known credentials of Console and Gateway resources and of the authentication requests, including
+// the ones whose key alone doesn't tell they are sensitive, like a Confluent API key.
+var sensitiveJSONPaths = [][]string{
+	{"password"},
+	{"access_token"},
+	{"refresh_token"},
+	{"token"},
+	{"spec", "kafkaFlavor", "apiToken"},
+	{"spec", "kafkaFlavor", "key"},
+	{"spec", "kafkaFlavor", "secret"},
+	{"spec", "kafkaFlavor", "password"},
+	{"spec", "schemaRegistry", "security", "password"},
+	{"spec", "schemaRegistry", "security", "token"},
+	{"spec", "schemaRegistry", "security", "key"},
+	{"spec", "schemaRegistry", "security", "secretKey"},
+	{"spec", "schemaRegistry", "security", "privateKey"},
+	{"spec", "security", "password"},
+	{"spec", "security", "token"},
+	{"spec", "security", "key"},
+}
+
+// Redactor masks the credentials of request and response bodies before they are logged.
+type Redactor struct {
+	keyPatterns []*regexp.Regexp
+}
+
+// NewRedactor returns a Redactor masking the known sensitive paths and the keys matching either the default
+// patterns or the given extra ones.
+func NewRedactor(extraPatterns []string) (*Redactor, error) {
+	redactor := &Redactor{}
+	for _, pattern := range append(slices.Clone(DefaultLogRedactPatterns), extraPatterns...) {
+		keyPattern, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log redact pattern %q: %s", pattern, err)
+		}
+		redactor.keyPatterns = append(redactor.keyPatterns, keyPattern)
+	}
+	return redactor, nil
+}
+
+// Redact returns the body with its sensitive values masked. Bodies that are not JSON are returned as is, as they
+// don't come from the Console or Gateway resource APIs.
+func (r *Redactor) Redact(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(r.redactValue(value, []string{}))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// RedactHeaders returns a copy of the headers with the credentials masked.
+func (r *Redactor) RedactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for key := range redacted {
+		if strings.EqualFold(key, "Authorization") || strings.EqualFold(key, "Cookie") || strings.EqualFold(key, "Set-Cookie") || r.sensitiveKey(key) {
+			redacted[key] = []string{redactedValue}
+		}
+	}
+	return redacted
+}
+
+func (r *Redactor) redactValue(value any, path []string) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			childPath := append(slices.Clone(path), key)
+			if r.sensitivePath(childPath) {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = r.redactValue(child, childPath)
+			}
+		}
+	case []any:
+		// Array elements share the path of the array, e.g. a list of resources has the same paths as each one.
+		for i, child := range typed {
+			typed[i] = r.redactValue(child, path)
+		}
+	}
+	return value
+}
+
+func (r *Redactor) sensitivePath(path []string) bool {
+	for _, sensitivePath := range sensitiveJSONPaths {
+		if slices.Equal(path, sensitivePath) {
+			return true
+		}
+	}
+	return r.sensitiveKey(path[len(path)-1])
+}
+
+func (r *Redactor) sensitiveKey(key string) bool {
+	for _, keyPattern := range r.keyPatterns {
+		if keyPattern.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	redactor, err := NewRedactor([]string{`^apiKey$`})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		body     string
+		leaked   []string
+		expected []string
+	}{
+		{
+			name:     "Confluent flavor",
+			body:     `{"kind":"KafkaCluster","spec":{"kafkaFlavor":{"type":"Confluent","key":"confluent-key","secret":"confluent-secret","confluentClusterId":"lkc-1"}}}`,
+			leaked:   []string{"confluent-key", "confluent-secret"},
+			expected: []string{`"confluentClusterId":"lkc-1"`},
+		},
+		{
+			name:   "Schema registry basic auth and Kafka properties",
+			body:   `{"spec":{"properties":{"sasl.jaas.config":"org.apache.kafka.common.security.plain.PlainLoginModule required password=\"kafka-secret\";","ssl.key.password":"ssl-secret","acks":"all"},"schemaRegistry":{"security":{"type":"BasicAuth","username":"sr-user","password":"sr-password"}}}}`,
+			leaked: []string{"kafka-secret", "ssl-secret", "sr-password"},
+			expected: []string{
+				`"acks":"all"`,
+				`"username":"sr-user"`,
+			},
+		},
+		{
+			name:     "Listed resources with extra pattern",
+			body:     `[{"spec":{"config":{"connection.url":"jdbc:postgresql://db","connection.password":"db-secret","apiKey":"custom-secret"}}}]`,
+			leaked:   []string{"db-secret", "custom-secret"},
+			expected: []string{`"connection.url":"jdbc:postgresql://db"`},
+		},
+		{
+			name:     "Gateway token",
+			body:     `{"token":"gateway-token"}`,
+			leaked:   []string{"gateway-token"},
+			expected: []string{`"token":"***"`},
+		},
+		{
+			name:     "Non JSON body",
+			body:     `upstream connect error`,
+			expected: []string{`upstream connect error`},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			redacted := redactor.Redact([]byte(tc.body))
+			for _, leaked := range tc.leaked {
+				if strings.Contains(redacted, leaked) {
+					t.Errorf("expected %q to be redacted from %s", leaked, redacted)
+				}
+			}
+			for _, expected := range tc.expected {
+				if !strings.Contains(redacted, expected) {
+					t.Errorf("expected %q to be kept in %s", expected, redacted)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	redactor, err := NewRedactor(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret-token")
+	headers.Set("Content-Type", "application/json")
+	redacted := redactor.RedactHeaders(headers)
+
+	if redacted.Get("Authorization") != redactedValue {
+		t.Errorf("expected Authorization header to be redacted, got %q", redacted.Get("Authorization"))
+	}
+	if redacted.Get("Content-Type") != "application/json" {
+		t.Errorf("expected Content-Type header to be kept, got %q", redacted.Get("Content-Type"))
+	}
+	if headers.Get("Authorization") != "Bearer secret-token" {
+		t.Error("expected original headers to be left untouched")
+	}
+}
+
+func TestNewRedactorInvalidPattern(t *testing.T) {
+	if _, err := NewRedactor([]string{`(unclosed`}); err == nil {
+		t.Fatal("expected error on invalid pattern")
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryPolicy defines how requests failing with a transient error are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	MaxAttempts int
+	// BaseBackoff is the wait duration before the first retry, doubled on each following retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait duration between two attempts, including the one asked by a Retry-After header.
+	MaxBackoff time.Duration
+	// Jitter randomizes wait durations between 0 and the computed backoff.
+	Jitter bool
+	// RetryableStatusCodes are the response status codes worth retrying.
+	RetryableStatusCodes []int
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      true,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// Only requests that can be sent several times without side effect are retried.
+// PUT is used by the API for upserts, so it is safe to retry as well.
+var idempotentMethods = []string{
+	resty.MethodGet,
+	resty.MethodHead,
+	resty.MethodOptions,
+	resty.MethodPut,
+	resty.MethodDelete,
+}
+
+func isIdempotent(method string) bool {
+	return slices.Contains(idempotentMethods, method)
+}
+
+// shouldRetry tells if a failed attempt is worth retrying, either on a network error or on a retryable status code.
+func (p RetryPolicy) shouldRetry(ctx context.Context, resp *resty.Response, err error) bool {
+	if ctx.Err() != nil {
+		// Cancelled by Terraform or timed out, no need to try again.
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp != nil && slices.Contains(p.RetryableStatusCodes, resp.StatusCode())
+}
+
+// backoff returns the wait duration before the given retry (starting at 1), using the Retry-After header of the
+// response if any.
+func (p RetryPolicy) backoff(retry int, resp *resty.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return min(wait, p.MaxBackoff)
+	}
+
+	wait := time.Duration(math.Min(float64(p.MaxBackoff), float64(p.BaseBackoff)*math.Exp2(float64(retry-1))))
+	if p.Jitter && wait > 0 {
+		wait = rand.N(wait + 1)
+	}
+	return wait
+}
+
+// retryAfter parses the Retry-After header, given either as a number of seconds or as an HTTP date.
+func retryAfter(resp *resty.Response) (time.Duration, bool) {
+	if resp == nil || resp.RawResponse == nil {
+		return 0, false
+	}
+	header := resp.Header().Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// executeWithRetry sends a request built by build, retrying it according to policy if it is idempotent.
+// Each attempt is bound to ctx and to the request timeout.
+func executeWithRetry(ctx context.Context, restyClient *resty.Client, policy RetryPolicy, timeout time.Duration, idempotent bool, method, url string, build func(*resty.Request)) (*resty.Response, error) {
+	maxAttempts := policy.MaxAttempts
+	if !idempotent || maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		req, cancel := newRequest(ctx, restyClient, timeout)
+		if build != nil {
+			build(req)
+		}
+		// Response body is fully read by resty before returning, so the request context can be released right away.
+		resp, err := req.Execute(method, url)
+		cancel()
+
+		if attempt >= maxAttempts || !policy.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt, resp)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = fmt.Sprintf("status code %d", resp.StatusCode())
+		}
+		tflog.Warn(ctx, fmt.Sprintf("%s %s failed with %s, retrying in %s (attempt %d/%d)", method, url, reason, wait, attempt+1, maxAttempts))
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
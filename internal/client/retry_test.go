@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	return policy
+}
+
+// Server answering with the given status codes in sequence, then 200 with an empty JSON object.
+func newFlakyServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit := int(hits.Add(1))
+		if hit <= len(statusCodes) {
+			w.WriteHeader(statusCodes[hit-1])
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, &hits
+}
+
+func TestExecuteRetriesTransientErrors(t *testing.T) {
+	ts, hits := newFlakyServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = c.Describe(context.Background(), "/public/v1/resource")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hits.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", hits.Load())
+	}
+}
+
+func TestExecuteStopsAfterMaxAttempts(t *testing.T) {
+	ts, hits := newFlakyServer(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+
+	c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = c.Apply(context.Background(), "/public/v1/resource", map[string]string{"kind": "Test"})
+	if err == nil {
+		t.Fatal("expected error once retries are exhausted")
+	}
+	if hits.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", hits.Load())
+	}
+}
+
+func TestExecuteDoesNotRetry(t *testing.T) {
+	cases := []struct {
+		name       string
+		method     string
+		statusCode int
+	}{
+		{"Non idempotent method", resty.MethodPost, http.StatusServiceUnavailable},
+		{"Non retryable status code", resty.MethodGet, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, hits := newFlakyServer(t, tc.statusCode)
+
+			c, err := Make(context.Background(), CONSOLE, ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key", RetryPolicy: testRetryPolicy()}, "test")
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			resp, err := c.Execute(context.Background(), tc.method, ts.URL+"/public/v1/resource", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.StatusCode() != tc.statusCode {
+				t.Errorf("expected status code %d, got %d", tc.statusCode, resp.StatusCode())
+			}
+			if hits.Load() != 1 {
+				t.Errorf("expected 1 attempt, got %d", hits.Load())
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseBackoff: 1 * time.Second, MaxBackoff: 5 * time.Second, Jitter: false}
+
+	cases := []struct {
+		name       string
+		retry      int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{"First retry", 1, "", 1 * time.Second},
+		{"Second retry", 2, "", 2 * time.Second},
+		{"Third retry", 3, "", 4 * time.Second},
+		{"Capped to max backoff", 4, "", 5 * time.Second},
+		{"Retry-After in seconds", 1, "3", 3 * time.Second},
+		{"Retry-After capped to max backoff", 1, "120", 5 * time.Second},
+		{"Invalid Retry-After", 2, "soon", 2 * time.Second},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+			if tc.retryAfter != "" {
+				resp.RawResponse.Header.Set("Retry-After", tc.retryAfter)
+			}
+			got := policy.backoff(tc.retry, resp)
+			if got != tc.expected {
+				t.Errorf("backoff(%d) = %s, want %s", tc.retry, got, tc.expected)
+			}
+		})
+	}
+
+	policy.Jitter = true
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(3, nil); got < 0 || got > 4*time.Second {
+			t.Fatalf("jittered backoff %s out of [0, 4s] range", got)
+		}
+	}
+}
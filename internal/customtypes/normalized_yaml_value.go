@@ -3,7 +3,9 @@ package customtypes
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
@@ -96,16 +98,24 @@ func yamlEqual(s1, s2 string) (bool, error) {
 	return reflect.DeepEqual(s1, s2), nil
 }
 
+// normalizeYAMLString normalizes every document of a YAML string, keeping the documents order.
 func normalizeYAMLString(yamlStr string) (string, error) {
 	dec := yaml.NewDecoder(strings.NewReader(yamlStr))
 
-	var temp any
-	if err := dec.Decode(&temp); err != nil {
-		return "", err
+	documents := []any{}
+	for {
+		var temp any
+		err := dec.Decode(&temp)
+		if errors.Is(err, io.EOF) && len(documents) > 0 {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		sortSlices(temp)
+		documents = append(documents, temp)
 	}
 
-	sortSlices(temp)
-	yamlBytes, err := yaml.Marshal(&temp)
+	yamlBytes, err := yaml.Marshal(&documents)
 	if err != nil {
 		return "", err
 	}
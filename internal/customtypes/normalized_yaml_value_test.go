@@ -0,0 +1,61 @@
+package customtypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYamlEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		s1       string
+		s2       string
+		expected bool
+	}{
+		{
+			name:     "same document with different key order",
+			s1:       "kind: Topic\nmetadata:\n  name: a\n",
+			s2:       "metadata:\n  name: a\nkind: Topic\n",
+			expected: true,
+		},
+		{
+			name:     "same documents with different formatting",
+			s1:       "kind: Topic\nmetadata: {name: a}\n---\nkind: Alert\nmetadata: {name: b}\n",
+			s2:       "kind: Topic\nmetadata:\n  name: a\n---\nkind: Alert\nmetadata:\n  name: b\n",
+			expected: true,
+		},
+		{
+			name:     "change in a following document",
+			s1:       "kind: Topic\nmetadata:\n  name: a\n---\nkind: Alert\nmetadata:\n  name: b\n",
+			s2:       "kind: Topic\nmetadata:\n  name: a\n---\nkind: Alert\nmetadata:\n  name: c\n",
+			expected: false,
+		},
+		{
+			name:     "additional document",
+			s1:       "kind: Topic\nmetadata:\n  name: a\n",
+			s2:       "kind: Topic\nmetadata:\n  name: a\n---\nkind: Alert\nmetadata:\n  name: b\n",
+			expected: false,
+		},
+		{
+			name:     "documents order matters",
+			s1:       "kind: Topic\n---\nkind: Alert\n",
+			s2:       "kind: Alert\n---\nkind: Topic\n",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := yamlEqual(tt.s1, tt.s2)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestYamlEqualEmpty(t *testing.T) {
+	_, err := yamlEqual("", "kind: Topic\n")
+	assert.Error(t, err)
+}
@@ -429,3 +429,23 @@ func NormalizeProtobufSchema(schema string) (string, error) {
 func NormalizeJSONSchema(schema string) (string, error) {
 	return normalizeJSONSchema(schema)
 }
+
+// DetectSchemaFormat returns the format of a schema: AVRO, PROTOBUF, JSON or UNKNOWN.
+func DetectSchemaFormat(schema string) string {
+	return detectSchemaFormat(schema)
+}
+
+// NormalizeSchema normalizes a schema according to its detected format, schemas of unknown format are returned as is.
+func NormalizeSchema(schema string) (string, error) {
+	return normalizeSchemaByFormat(schema, detectSchemaFormat(schema))
+}
+
+// SchemaEqual compares two schemas for semantic equality, each of them being parsed in its own detected format.
+// Schemas of different formats are never equal.
+func SchemaEqual(schema1, schema2 string) (bool, error) {
+	format := detectSchemaFormat(schema1)
+	if detectSchemaFormat(schema2) != format {
+		return false, nil
+	}
+	return schemaEqual(schema1, schema2, format)
+}
@@ -0,0 +1,116 @@
+package console_alert_v3
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_alert_v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func InternalModelToTerraform(ctx context.Context, r *console.AlertConsoleResource) (schema.ConsoleAlertV3Model, error) {
+	destination, err := destinationToObjectValue(ctx, r.Spec.Destination)
+	if err != nil {
+		return schema.ConsoleAlertV3Model{}, err
+	}
+
+	specValue, diag := schema.NewSpecValue(
+		map[string]attr.Type{
+			"cluster":             basetypes.StringType{},
+			"type":                basetypes.StringType{},
+			"topic_name":          basetypes.StringType{},
+			"consumer_group_name": basetypes.StringType{},
+			"connect_name":        basetypes.StringType{},
+			"connector_name":      basetypes.StringType{},
+			"metric":              basetypes.StringType{},
+			"operator":            basetypes.StringType{},
+			"threshold":           basetypes.Int64Type{},
+			"description":         basetypes.StringType{},
+			"disable":             basetypes.BoolType{},
+			"destination":         destination.Type(ctx),
+			"prom_ql":             basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"cluster":             schemaUtils.NewStringValue(r.Spec.Cluster),
+			"type":                schemaUtils.NewStringValue(r.Spec.Type),
+			"topic_name":          schemaUtils.NewStringValue(r.Spec.TopicName),
+			"consumer_group_name": schemaUtils.NewStringValue(r.Spec.ConsumerGroupName),
+			"connect_name":        schemaUtils.NewStringValue(r.Spec.ConnectName),
+			"connector_name":      schemaUtils.NewStringValue(r.Spec.ConnectorName),
+			"metric":              schemaUtils.NewStringValue(r.Spec.Metric),
+			"operator":            schemaUtils.NewStringValue(r.Spec.Operator),
+			"threshold":           types.Int64Value(r.Spec.Threshold),
+			"description":         schemaUtils.NewStringValue(r.Spec.Description),
+			"disable":             types.BoolValue(r.Spec.Disable),
+			"destination":         destination,
+			// Always known after apply, even if Console doesn't compute it
+			"prom_ql": types.StringValue(r.Spec.PromQl),
+		},
+	)
+	if diag.HasError() {
+		return schema.ConsoleAlertV3Model{}, mapper.WrapDiagError(diag, "spec", mapper.IntoTerraform)
+	}
+
+	return schema.ConsoleAlertV3Model{
+		Name:        types.StringValue(r.Metadata.Name),
+		AppInstance: schemaUtils.NewStringValue(r.Metadata.AppInstance),
+		Group:       schemaUtils.NewStringValue(r.Metadata.Group),
+		User:        schemaUtils.NewStringValue(r.Metadata.User),
+		UpdatedAt:   types.StringValue(r.Metadata.UpdatedAt),
+		Spec:        specValue,
+	}, nil
+}
+
+func destinationToObjectValue(ctx context.Context, r console.AlertDestination) (basetypes.ObjectValue, error) {
+	emails := types.SetNull(types.StringType)
+	if len(r.Emails) > 0 {
+		emailsSet, diag := schemaUtils.StringArrayToSetValue(r.Emails)
+		if diag.HasError() {
+			return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "destination.emails", mapper.IntoTerraform)
+		}
+		emails = emailsSet
+	}
+
+	headers := types.MapNull(types.StringType)
+	if len(r.Headers) > 0 {
+		headersMap, diag := schemaUtils.StringMapToMapValue(ctx, r.Headers)
+		if diag.HasError() {
+			return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "destination.headers", mapper.IntoTerraform)
+		}
+		headers = headersMap
+	}
+
+	destination, diag := schema.NewDestinationValue(
+		map[string]attr.Type{
+			"type":    basetypes.StringType{},
+			"channel": basetypes.StringType{},
+			"url":     basetypes.StringType{},
+			"emails":  emails.Type(ctx),
+			"method":  basetypes.StringType{},
+			"headers": headers.Type(ctx),
+			"body":    basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"type":    schemaUtils.NewStringValue(r.Type),
+			"channel": schemaUtils.NewStringValue(r.Channel),
+			"url":     schemaUtils.NewStringValue(r.Url),
+			"emails":  emails,
+			"method":  schemaUtils.NewStringValue(r.Method),
+			"headers": headers,
+			"body":    schemaUtils.NewStringValue(r.Body),
+		},
+	)
+	if diag.HasError() {
+		return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "destination", mapper.IntoTerraform)
+	}
+
+	object, diag := destination.ToObjectValue(ctx)
+	if diag.HasError() {
+		return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "destination", mapper.IntoTerraform)
+	}
+	return object, nil
+}
@@ -0,0 +1,85 @@
+package console_alert_v3
+
+import (
+	"context"
+	"testing"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertV3ModelMapping(t *testing.T) {
+
+	ctx := context.Background()
+
+	jsonAlertV3Resource := []byte(test.TestAccTestdata(t, "console/alert_v3/api.json"))
+
+	ctlResource := ctlresource.Resource{}
+	err := ctlResource.UnmarshalJSON(jsonAlertV3Resource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "Alert", ctlResource.Kind)
+	assert.Equal(t, "v3", ctlResource.Version)
+	assert.Equal(t, "orders-lag", ctlResource.Name)
+	assert.Equal(t, map[string]any{"name": "orders-lag", "group": "support-team", "updatedAt": "2025-03-04T10:15:30.123Z"}, ctlResource.Metadata)
+	assert.Equal(t, jsonAlertV3Resource, ctlResource.Json)
+
+	// convert into internal model
+	internal, err := console.NewAlertConsoleResourceFromClientResource(ctlResource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "Alert", internal.Kind)
+	assert.Equal(t, "v3", internal.ApiVersion)
+	assert.Equal(t, "orders-lag", internal.Metadata.Name)
+	assert.Equal(t, "support-team", internal.Metadata.Group)
+	assert.Equal(t, "2025-03-04T10:15:30.123Z", internal.Metadata.UpdatedAt)
+	assert.Equal(t, "ConsumerGroupAlert", internal.Spec.Type)
+	assert.Equal(t, "orders-consumer", internal.Spec.ConsumerGroupName)
+	assert.Equal(t, int64(1000), internal.Spec.Threshold)
+	assert.Equal(t, console.AlertDestination{
+		Type:    "Webhook",
+		Url:     "https://example.com/hook",
+		Method:  "POST",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Body:    "{}",
+	}, internal.Spec.Destination)
+	assert.Contains(t, internal.Spec.PromQl, "kafka_consumergroup_lag")
+
+	// convert to terraform model
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("orders-lag"), tfModel.Name)
+	assert.Equal(t, types.StringValue("support-team"), tfModel.Group)
+	assert.Equal(t, types.StringNull(), tfModel.User)
+	assert.Equal(t, types.StringNull(), tfModel.AppInstance)
+	assert.Equal(t, types.StringValue("2025-03-04T10:15:30.123Z"), tfModel.UpdatedAt)
+	assert.Equal(t, types.StringValue("kafka-cluster"), tfModel.Spec.Cluster)
+	assert.Equal(t, types.StringNull(), tfModel.Spec.TopicName)
+	assert.Equal(t, types.Int64Value(1000), tfModel.Spec.Threshold)
+	assert.Equal(t, types.StringValue(internal.Spec.PromQl), tfModel.Spec.PromQl)
+	assert.Equal(t, types.StringValue("Webhook"), tfModel.Spec.Destination.Attributes()["type"])
+	assert.Equal(t, types.StringNull(), tfModel.Spec.Destination.Attributes()["channel"])
+	assert.True(t, tfModel.Spec.Destination.Attributes()["emails"].IsNull())
+
+	// convert back to internal model, without the fields computed by Console
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	expected := internal
+	expected.Metadata.UpdatedAt = ""
+	expected.Spec.PromQl = ""
+	assert.Equal(t, expected, internal2)
+}
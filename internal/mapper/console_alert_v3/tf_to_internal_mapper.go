@@ -0,0 +1,72 @@
+package console_alert_v3
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_alert_v3"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TFToInternalModel(ctx context.Context, r *schema.ConsoleAlertV3Model) (console.AlertConsoleResource, error) {
+	destination, err := objectValueToDestination(ctx, r.Spec.Destination)
+	if err != nil {
+		return console.AlertConsoleResource{}, err
+	}
+
+	return console.NewAlertConsoleResource(
+		console.AlertConsoleMetadata{
+			Name:        r.Name.ValueString(),
+			AppInstance: r.AppInstance.ValueString(),
+			Group:       r.Group.ValueString(),
+			User:        r.User.ValueString(),
+		},
+		console.AlertConsoleSpec{
+			Cluster:           r.Spec.Cluster.ValueString(),
+			Type:              r.Spec.SpecType.ValueString(),
+			TopicName:         r.Spec.TopicName.ValueString(),
+			ConsumerGroupName: r.Spec.ConsumerGroupName.ValueString(),
+			ConnectName:       r.Spec.ConnectName.ValueString(),
+			ConnectorName:     r.Spec.ConnectorName.ValueString(),
+			Metric:            r.Spec.Metric.ValueString(),
+			Operator:          r.Spec.Operator.ValueString(),
+			Threshold:         r.Spec.Threshold.ValueInt64(),
+			Description:       r.Spec.Description.ValueString(),
+			Disable:           r.Spec.Disable.ValueBool(),
+			Destination:       destination,
+		},
+	), nil
+}
+
+func objectValueToDestination(ctx context.Context, r basetypes.ObjectValue) (console.AlertDestination, error) {
+	if r.IsNull() || r.IsUnknown() {
+		return console.AlertDestination{}, nil
+	}
+
+	destination, diag := schema.NewDestinationValue(r.AttributeTypes(ctx), r.Attributes())
+	if diag.HasError() {
+		return console.AlertDestination{}, mapper.WrapDiagError(diag, "destination", mapper.FromTerraform)
+	}
+
+	emails, diag := schemaUtils.SetValueToStringArray(ctx, destination.Emails)
+	if diag.HasError() {
+		return console.AlertDestination{}, mapper.WrapDiagError(diag, "destination.emails", mapper.FromTerraform)
+	}
+
+	headers, diag := schemaUtils.MapValueToStringMap(ctx, destination.Headers)
+	if diag.HasError() {
+		return console.AlertDestination{}, mapper.WrapDiagError(diag, "destination.headers", mapper.FromTerraform)
+	}
+
+	return console.AlertDestination{
+		Type:    destination.DestinationType.ValueString(),
+		Channel: destination.Channel.ValueString(),
+		Url:     destination.Url.ValueString(),
+		Emails:  emails,
+		Method:  destination.Method.ValueString(),
+		Headers: headers,
+		Body:    destination.Body.ValueString(),
+	}, nil
+}
@@ -2,12 +2,15 @@ package console_connector_v2
 
 import (
 	"context"
+	"slices"
 
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	connector "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_connector_v2"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
@@ -92,10 +95,53 @@ func InternalModelToTerraform(ctx context.Context, r *console.ConnectorConsoleRe
 		ManagedLabels:  managedLabels,
 		Description:    schema.NewStringValue(r.Metadata.Description),
 		AutoRestart:    autoRestart,
-		Spec:           specValue,
+		// Lifecycle attributes are not part of the Console resource, see StatusToTerraform.
+		State:           types.StringNull(),
+		RestartTriggers: types.MapNull(types.StringType),
+		WaitForRunning:  connector.NewWaitForRunningValueNull(),
+		Spec:            specValue,
+		Tasks:           types.ListNull(connector.TasksValue{}.Type(ctx)),
 	}, nil
 }
 
+// StatusToTerraform sets the state and tasks of a Terraform model from the connector status.
+// The state is only set if the connector is in one of the states the resource manages, failed or restarting
+// connectors keep their desired state.
+func StatusToTerraform(ctx context.Context, status *console.ConnectorStatus, r *connector.ConsoleConnectorV2Model) error {
+	if slices.Contains(validation.ValidConnectorStates, status.ConnectorState) {
+		r.State = types.StringValue(status.ConnectorState)
+	}
+
+	tasks := make([]attr.Value, 0, len(status.Tasks))
+	for _, task := range status.Tasks {
+		taskValue, diag := connector.NewTasksValue(
+			map[string]attr.Type{
+				"id":        basetypes.Int64Type{},
+				"state":     basetypes.StringType{},
+				"worker_id": basetypes.StringType{},
+				"trace":     basetypes.StringType{},
+			},
+			map[string]attr.Value{
+				"id":        basetypes.NewInt64Value(task.Id),
+				"state":     basetypes.NewStringValue(task.State),
+				"worker_id": schema.NewStringValue(task.WorkerId),
+				"trace":     schema.NewStringValue(task.Trace),
+			},
+		)
+		if diag.HasError() {
+			return mapper.WrapDiagError(diag, "tasks", mapper.IntoTerraform)
+		}
+		tasks = append(tasks, taskValue)
+	}
+
+	tasksList, diag := types.ListValue(connector.TasksValue{}.Type(ctx), tasks)
+	if diag.HasError() {
+		return mapper.WrapDiagError(diag, "tasks", mapper.IntoTerraform)
+	}
+	r.Tasks = tasksList
+	return nil
+}
+
 func autoRestartInternalToTerraform(r *console.AutoRestart) (connector.AutoRestartValue, error) {
 	if r == nil {
 		return connector.NewAutoRestartValueNull(), nil
@@ -6,6 +6,7 @@ import (
 
 	ctlresource "github.com/conduktor/ctl/resource"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	connector "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_connector_v2"
 	"github.com/conduktor/terraform-provider-conduktor/internal/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -135,3 +136,54 @@ func TestConnectorV2ModelMapping(t *testing.T) {
 		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
 	}
 }
+
+func TestConnectorV2StatusMapping(t *testing.T) {
+	ctx := context.Background()
+
+	internal := console.NewConnectorConsoleResource(
+		console.ConnectorConsoleMetadata{Name: "connector", Cluster: "cluster", ConnectCluster: "connect"},
+		console.ConnectorConsoleSpec{Config: map[string]string{"tasks.max": "2"}},
+	)
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, true, tfModel.State.IsNull())
+	assert.Equal(t, true, tfModel.RestartTriggers.IsNull())
+	assert.Equal(t, true, tfModel.WaitForRunning.IsNull())
+	assert.Equal(t, true, tfModel.Tasks.IsNull())
+
+	status := console.ConnectorStatus{
+		ConnectorState: "PAUSED",
+		Tasks: []console.ConnectorTaskStatus{
+			{Id: 0, State: "PAUSED", WorkerId: "connect:8083"},
+			{Id: 1, State: "FAILED", WorkerId: "connect:8083", Trace: "org.apache.kafka.connect.errors.ConnectException"},
+		},
+	}
+	assert.Equal(t, []int64{1}, status.FailedTasks())
+
+	err = StatusToTerraform(ctx, &status, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("PAUSED"), tfModel.State)
+	assert.Equal(t, 2, len(tfModel.Tasks.Elements()))
+	failedTask := tfModel.Tasks.Elements()[1].(connector.TasksValue)
+	assert.Equal(t, types.Int64Value(1), failedTask.Id)
+	assert.Equal(t, types.StringValue("FAILED"), failedTask.State)
+	assert.Equal(t, types.StringValue("connect:8083"), failedTask.WorkerId)
+	assert.Equal(t, types.StringValue("org.apache.kafka.connect.errors.ConnectException"), failedTask.Trace)
+	assert.Equal(t, true, tfModel.Tasks.Elements()[0].(connector.TasksValue).Trace.IsNull())
+
+	// connectors not in a managed state keep their desired state
+	tfModel.State = types.StringValue("RUNNING")
+	err = StatusToTerraform(ctx, &console.ConnectorStatus{ConnectorState: "FAILED"}, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("RUNNING"), tfModel.State)
+	assert.Equal(t, 0, len(tfModel.Tasks.Elements()))
+}
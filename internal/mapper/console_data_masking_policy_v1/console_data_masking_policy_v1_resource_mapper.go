@@ -0,0 +1,112 @@
+package console_data_masking_policy_v1
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	policies "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_data_masking_policy_v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TFToInternalModel(ctx context.Context, r *policies.ConsoleDataMaskingPolicyV1Model) (console.DataMaskingPolicyConsoleResource, error) {
+	fields, diag := schema.SetValueToStringArray(ctx, r.Spec.Fields)
+	if diag.HasError() {
+		return console.DataMaskingPolicyConsoleResource{}, mapper.WrapDiagError(diag, "fields", mapper.FromTerraform)
+	}
+
+	exemptedGroups, diag := schema.SetValueToStringArray(ctx, r.Spec.ExemptedGroups)
+	if diag.HasError() {
+		return console.DataMaskingPolicyConsoleResource{}, mapper.WrapDiagError(diag, "exempted_groups", mapper.FromTerraform)
+	}
+
+	rule, err := objectValueToRule(ctx, r.Spec.Rule)
+	if err != nil {
+		return console.DataMaskingPolicyConsoleResource{}, err
+	}
+
+	return console.NewDataMaskingPolicyConsoleResource(
+		r.Name.ValueString(),
+		console.DataMaskingPolicyConsoleSpec{
+			Description:    r.Spec.Description.ValueString(),
+			Fields:         fields,
+			Rule:           rule,
+			ExemptedGroups: exemptedGroups,
+		},
+	), nil
+}
+
+func objectValueToRule(ctx context.Context, r basetypes.ObjectValue) (console.DataMaskingRule, error) {
+	if r.IsNull() || r.IsUnknown() {
+		return console.DataMaskingRule{}, nil
+	}
+
+	rule, diag := policies.NewRuleValue(r.AttributeTypes(ctx), r.Attributes())
+	if diag.HasError() {
+		return console.DataMaskingRule{}, mapper.WrapDiagError(diag, "rule", mapper.FromTerraform)
+	}
+
+	return console.DataMaskingRule{
+		Type:          rule.RuleType.ValueString(),
+		MaskingChar:   rule.MaskingChar.ValueString(),
+		NumberOfChars: rule.NumberOfChars.ValueInt64(),
+	}, nil
+}
+
+func InternalModelToTerraform(ctx context.Context, r *console.DataMaskingPolicyConsoleResource) (policies.ConsoleDataMaskingPolicyV1Model, error) {
+	fieldsList, diag := schema.StringArrayToSetValue(r.Spec.Fields)
+	if diag.HasError() {
+		return policies.ConsoleDataMaskingPolicyV1Model{}, mapper.WrapDiagError(diag, "fields", mapper.IntoTerraform)
+	}
+
+	exemptedGroupsList, diag := schema.StringArrayToSetValue(r.Spec.ExemptedGroups)
+	if diag.HasError() {
+		return policies.ConsoleDataMaskingPolicyV1Model{}, mapper.WrapDiagError(diag, "exempted_groups", mapper.IntoTerraform)
+	}
+
+	ruleValue, diag := policies.NewRuleValue(
+		map[string]attr.Type{
+			"type":            basetypes.StringType{},
+			"masking_char":    basetypes.StringType{},
+			"number_of_chars": basetypes.Int64Type{},
+		},
+		map[string]attr.Value{
+			"type":            schema.NewStringValue(r.Spec.Rule.Type),
+			"masking_char":    schema.NewStringValue(r.Spec.Rule.MaskingChar),
+			"number_of_chars": schema.NewInt64Value(r.Spec.Rule.NumberOfChars),
+		},
+	)
+	if diag.HasError() {
+		return policies.ConsoleDataMaskingPolicyV1Model{}, mapper.WrapDiagError(diag, "rule", mapper.IntoTerraform)
+	}
+	ruleObject, diag := ruleValue.ToObjectValue(ctx)
+	if diag.HasError() {
+		return policies.ConsoleDataMaskingPolicyV1Model{}, mapper.WrapDiagError(diag, "rule", mapper.IntoTerraform)
+	}
+
+	specValue, diag := policies.NewSpecValue(
+		map[string]attr.Type{
+			"description":     basetypes.StringType{},
+			"fields":          fieldsList.Type(ctx),
+			"rule":            ruleObject.Type(ctx),
+			"exempted_groups": exemptedGroupsList.Type(ctx),
+		},
+		map[string]attr.Value{
+			"description":     schema.NewStringValue(r.Spec.Description),
+			"fields":          fieldsList,
+			"rule":            ruleObject,
+			"exempted_groups": exemptedGroupsList,
+		},
+	)
+	if diag.HasError() {
+		return policies.ConsoleDataMaskingPolicyV1Model{}, mapper.WrapDiagError(diag, "spec", mapper.IntoTerraform)
+	}
+
+	return policies.ConsoleDataMaskingPolicyV1Model{
+		Name: types.StringValue(r.Metadata.Name),
+		Spec: specValue,
+	}, nil
+}
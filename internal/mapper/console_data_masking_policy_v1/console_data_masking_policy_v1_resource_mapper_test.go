@@ -0,0 +1,111 @@
+package console_data_masking_policy_v1
+
+import (
+	"context"
+	"testing"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataMaskingPolicyV1ModelMapping(t *testing.T) {
+
+	ctx := context.Background()
+
+	jsonDataMaskingPolicyV1Resource := []byte(test.TestAccTestdata(t, "console/data_masking_policy_v1/api.json"))
+
+	ctlResource := ctlresource.Resource{}
+	err := ctlResource.UnmarshalJSON(jsonDataMaskingPolicyV1Resource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "DataMaskingPolicy", ctlResource.Kind)
+	assert.Equal(t, "v1", ctlResource.Version)
+	assert.Equal(t, "mask-pii", ctlResource.Name)
+	assert.Equal(t, map[string]any{"name": "mask-pii"}, ctlResource.Metadata)
+	assert.Equal(t, jsonDataMaskingPolicyV1Resource, ctlResource.Json)
+
+	// convert into internal model
+	internal, err := console.NewDataMaskingPolicyConsoleResourceFromClientResource(ctlResource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "DataMaskingPolicy", internal.Kind)
+	assert.Equal(t, "v1", internal.ApiVersion)
+	assert.Equal(t, "mask-pii", internal.Metadata.Name)
+	assert.Equal(t, "Mask customer personal data", internal.Spec.Description)
+	assert.Equal(t, []string{"customer.email", "customer.phone"}, internal.Spec.Fields)
+	expectedRule := console.DataMaskingRule{Type: "MASK_FIRST_N", MaskingChar: "#", NumberOfChars: 4}
+	assert.Equal(t, expectedRule, internal.Spec.Rule)
+	assert.Equal(t, []string{"compliance"}, internal.Spec.ExemptedGroups)
+
+	// convert to terraform model
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("mask-pii"), tfModel.Name)
+	assert.Equal(t, types.StringValue("Mask customer personal data"), tfModel.Spec.Description)
+	assert.Equal(t, types.StringValue("MASK_FIRST_N"), tfModel.Spec.Rule.Attributes()["type"])
+	assert.Equal(t, types.StringValue("#"), tfModel.Spec.Rule.Attributes()["masking_char"])
+	assert.Equal(t, types.Int64Value(4), tfModel.Spec.Rule.Attributes()["number_of_chars"])
+
+	// convert back to internal model
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "DataMaskingPolicy", internal2.Kind)
+	assert.Equal(t, "v1", internal2.ApiVersion)
+	assert.Equal(t, "mask-pii", internal2.Metadata.Name)
+	assert.Equal(t, expectedRule, internal2.Spec.Rule)
+	assert.Equal(t, []string{"compliance"}, internal2.Spec.ExemptedGroups)
+	assert.Equal(t, internal, internal2)
+
+	// convert back to ctl model
+	ctlResource2, err := internal2.ToClientResource()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	// compare without json
+	if !cmp.Equal(ctlResource, ctlResource2, cmpopts.IgnoreFields(ctlresource.Resource{}, "Json")) {
+		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
+	}
+}
+
+func TestDataMaskingPolicyV1MaskAllMapping(t *testing.T) {
+	ctx := context.Background()
+
+	internal := console.NewDataMaskingPolicyConsoleResource("mask-all", console.DataMaskingPolicyConsoleSpec{
+		Fields: []string{"ssn"},
+		Rule:   console.DataMaskingRule{Type: "MASK_ALL"},
+	})
+
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringNull(), tfModel.Spec.Description)
+	assert.Equal(t, types.StringNull(), tfModel.Spec.Rule.Attributes()["masking_char"])
+	assert.Equal(t, types.Int64Null(), tfModel.Spec.Rule.Attributes()["number_of_chars"])
+	assert.Empty(t, tfModel.Spec.ExemptedGroups.Elements())
+
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, internal.Spec.Rule, internal2.Spec.Rule)
+	assert.Equal(t, []string{"ssn"}, internal2.Spec.Fields)
+}
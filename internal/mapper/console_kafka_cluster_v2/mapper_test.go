@@ -10,6 +10,7 @@ import (
 	"github.com/conduktor/terraform-provider-conduktor/internal/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -267,3 +268,75 @@ func TestMinimalKafkaClusterV2ModelMapping(t *testing.T) {
 		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
 	}
 }
+
+func TestKafkaClusterV2WriteOnlySecrets(t *testing.T) {
+
+	ctx := context.Background()
+
+	newResource := func() console.KafkaClusterResource {
+		return console.NewKafkaClusterResource("cluster-name", nil, console.KafkaClusterSpec{
+			DisplayName:      "Cluster display name",
+			BootstrapServers: "localhost:9092",
+			Properties:       map[string]string{"security.protocol": "SASL_SSL"},
+			KafkaFlavor:      &console.KafkaFlavor{Confluent: &console.Confluent{Key: "key"}},
+			SchemaRegistry: &model.SchemaRegistry{ConfluentLike: &model.ConfluentLike{
+				Url:      "http://localhost:8080",
+				Security: model.ConfluentLikeSchemaRegistrySecurity{BasicAuth: &model.BasicAuth{UserName: "some_user"}},
+			}},
+		})
+	}
+	secrets := WriteOnlySecrets{
+		ConfluentSecretWo:               types.StringValue("secret"),
+		ConfluentSecretWoVersion:        types.Int64Value(1),
+		AivenApiTokenWo:                 types.StringValue("unused"),
+		SchemaRegistryPasswordWo:        types.StringValue("some_password"),
+		SchemaRegistryPasswordWoVersion: types.Int64Value(1),
+		PropertiesWo: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"sasl.jaas.config": types.StringValue("org.apache.kafka.common.security.plain.PlainLoginModule required username=\"admin\" password=\"admin-secret\";"),
+		}),
+		PropertiesWoVersion: types.Int64Value(1),
+	}
+
+	// write-only secrets are sent on apply
+	internal := newResource()
+	err := ApplyWriteOnlySecrets(ctx, &internal, secrets)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "secret", internal.Spec.KafkaFlavor.Confluent.Secret)
+	assert.Nil(t, internal.Spec.KafkaFlavor.Aiven)
+	assert.Equal(t, "some_password", internal.Spec.SchemaRegistry.ConfluentLike.Security.BasicAuth.Password)
+	assert.Equal(t, map[string]string{
+		"security.protocol": "SASL_SSL",
+		"sasl.jaas.config":  "org.apache.kafka.common.security.plain.PlainLoginModule required username=\"admin\" password=\"admin-secret\";",
+	}, internal.Spec.Properties)
+
+	// but never mapped back into state
+	desired := newResource()
+	tfModel, err := InternalModelToTerraform(ctx, &desired)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	err = ForgetWriteOnlySecrets(ctx, &internal, &tfModel, secrets)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "", internal.Spec.KafkaFlavor.Confluent.Secret)
+	assert.Equal(t, "key", internal.Spec.KafkaFlavor.Confluent.Key)
+	assert.Equal(t, "", internal.Spec.SchemaRegistry.ConfluentLike.Security.BasicAuth.Password)
+	assert.Equal(t, map[string]string{"security.protocol": "SASL_SSL"}, internal.Spec.Properties)
+
+	// secrets without version are kept as before
+	internal = newResource()
+	internal.Spec.KafkaFlavor.Confluent.Secret = "secret"
+	err = ForgetWriteOnlySecrets(ctx, &internal, &tfModel, WriteOnlySecrets{})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "secret", internal.Spec.KafkaFlavor.Confluent.Secret)
+	assert.Equal(t, map[string]string{"security.protocol": "SASL_SSL"}, internal.Spec.Properties)
+}
@@ -0,0 +1,141 @@
+package console_kafka_cluster_v2
+
+import (
+	"context"
+	"maps"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	"github.com/conduktor/terraform-provider-conduktor/internal/model"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_cluster_v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlySecrets holds the write-only variants of the kafka cluster secrets along with their versions.
+// Secret values are only available in the configuration, they are always null in plan and state.
+type WriteOnlySecrets struct {
+	ConfluentSecretWo                types.String `tfsdk:"confluent_secret_wo"`
+	ConfluentSecretWoVersion         types.Int64  `tfsdk:"confluent_secret_wo_version"`
+	AivenApiTokenWo                  types.String `tfsdk:"aiven_api_token_wo"`
+	AivenApiTokenWoVersion           types.Int64  `tfsdk:"aiven_api_token_wo_version"`
+	GatewayPasswordWo                types.String `tfsdk:"gateway_password_wo"`
+	GatewayPasswordWoVersion         types.Int64  `tfsdk:"gateway_password_wo_version"`
+	SchemaRegistryPasswordWo         types.String `tfsdk:"schema_registry_password_wo"`
+	SchemaRegistryPasswordWoVersion  types.Int64  `tfsdk:"schema_registry_password_wo_version"`
+	SchemaRegistryTokenWo            types.String `tfsdk:"schema_registry_token_wo"`
+	SchemaRegistryTokenWoVersion     types.Int64  `tfsdk:"schema_registry_token_wo_version"`
+	SchemaRegistrySslKeyWo           types.String `tfsdk:"schema_registry_ssl_key_wo"`
+	SchemaRegistrySslKeyWoVersion    types.Int64  `tfsdk:"schema_registry_ssl_key_wo_version"`
+	SchemaRegistrySecretKeyWo        types.String `tfsdk:"schema_registry_secret_key_wo"`
+	SchemaRegistrySecretKeyWoVersion types.Int64  `tfsdk:"schema_registry_secret_key_wo_version"`
+	PropertiesWo                     types.Map    `tfsdk:"properties_wo"`
+	PropertiesWoVersion              types.Int64  `tfsdk:"properties_wo_version"`
+}
+
+// ApplyWriteOnlySecrets sets the write-only secrets of the configuration on the kafka cluster to apply.
+// Write-only properties are merged over the ones of `spec.properties`.
+func ApplyWriteOnlySecrets(ctx context.Context, r *console.KafkaClusterResource, config WriteOnlySecrets) error {
+	if flavor := r.Spec.KafkaFlavor; flavor != nil {
+		if flavor.Confluent != nil && schemaUtils.AttrIsSet(config.ConfluentSecretWo) {
+			flavor.Confluent.Secret = config.ConfluentSecretWo.ValueString()
+		}
+		if flavor.Aiven != nil && schemaUtils.AttrIsSet(config.AivenApiTokenWo) {
+			flavor.Aiven.ApiToken = config.AivenApiTokenWo.ValueString()
+		}
+		if flavor.Gateway != nil && schemaUtils.AttrIsSet(config.GatewayPasswordWo) {
+			flavor.Gateway.Password = config.GatewayPasswordWo.ValueString()
+		}
+	}
+
+	if registry := r.Spec.SchemaRegistry; registry != nil {
+		if registry.ConfluentLike != nil {
+			schemaRegistrySecrets(&registry.ConfluentLike.Security).ApplyWriteOnly(config.schemaRegistrySecrets())
+		}
+		if registry.Glue != nil && registry.Glue.Security.Credentials != nil && schemaUtils.AttrIsSet(config.SchemaRegistrySecretKeyWo) {
+			registry.Glue.Security.Credentials.SecretKey = config.SchemaRegistrySecretKeyWo.ValueString()
+		}
+	}
+
+	if schemaUtils.AttrIsSet(config.PropertiesWo) {
+		properties, diag := schemaUtils.MapValueToStringMap(ctx, config.PropertiesWo)
+		if diag.HasError() {
+			return mapper.WrapDiagError(diag, "properties_wo", mapper.FromTerraform)
+		}
+		if r.Spec.Properties == nil {
+			r.Spec.Properties = make(map[string]string, len(properties))
+		}
+		maps.Copy(r.Spec.Properties, properties)
+	}
+	return nil
+}
+
+// ForgetWriteOnlySecrets clears from a kafka cluster returned by the API the secrets managed through a write-only
+// attribute, i.e. the ones with a version set, so that they are never stored in state and their drift is ignored.
+// When `properties_wo` is used, only the properties declared in `spec.properties` of the desired model are kept.
+func ForgetWriteOnlySecrets(ctx context.Context, r *console.KafkaClusterResource, desired *schema.ConsoleKafkaClusterV2Model, versions WriteOnlySecrets) error {
+	if flavor := r.Spec.KafkaFlavor; flavor != nil {
+		if flavor.Confluent != nil && !versions.ConfluentSecretWoVersion.IsNull() {
+			flavor.Confluent.Secret = ""
+		}
+		if flavor.Aiven != nil && !versions.AivenApiTokenWoVersion.IsNull() {
+			flavor.Aiven.ApiToken = ""
+		}
+		if flavor.Gateway != nil && !versions.GatewayPasswordWoVersion.IsNull() {
+			flavor.Gateway.Password = ""
+		}
+	}
+
+	if registry := r.Spec.SchemaRegistry; registry != nil {
+		if registry.ConfluentLike != nil {
+			schemaRegistrySecrets(&registry.ConfluentLike.Security).ForgetWriteOnly(versions.schemaRegistrySecrets())
+		}
+		if registry.Glue != nil && registry.Glue.Security.Credentials != nil && !versions.SchemaRegistrySecretKeyWoVersion.IsNull() {
+			registry.Glue.Security.Credentials.SecretKey = ""
+		}
+	}
+
+	if !versions.PropertiesWoVersion.IsNull() {
+		declared := map[string]string{}
+		if !desired.Spec.IsNull() && schemaUtils.AttrIsSet(desired.Spec.Properties) {
+			var diag = desired.Spec.Properties.ElementsAs(ctx, &declared, true)
+			if diag.HasError() {
+				return mapper.WrapDiagError(diag, "properties", mapper.FromTerraform)
+			}
+		}
+		maps.DeleteFunc(r.Spec.Properties, func(key string, _ string) bool {
+			_, ok := declared[key]
+			return !ok
+		})
+		if len(r.Spec.Properties) == 0 && desired.Spec.Properties.IsNull() {
+			r.Spec.Properties = nil
+		}
+	}
+	return nil
+}
+
+// schemaRegistrySecrets returns the write-only secrets of the Confluent like schema registry security.
+func (s WriteOnlySecrets) schemaRegistrySecrets() mapper.SecurityWriteOnlySecrets {
+	return mapper.SecurityWriteOnlySecrets{
+		SecurityPasswordWo:        s.SchemaRegistryPasswordWo,
+		SecurityPasswordWoVersion: s.SchemaRegistryPasswordWoVersion,
+		SecurityTokenWo:           s.SchemaRegistryTokenWo,
+		SecurityTokenWoVersion:    s.SchemaRegistryTokenWoVersion,
+		SecuritySslKeyWo:          s.SchemaRegistrySslKeyWo,
+		SecuritySslKeyWoVersion:   s.SchemaRegistrySslKeyWoVersion,
+	}
+}
+
+func schemaRegistrySecrets(security *model.ConfluentLikeSchemaRegistrySecurity) mapper.SecuritySecrets {
+	var secrets mapper.SecuritySecrets
+	if security.BasicAuth != nil {
+		secrets.Password = &security.BasicAuth.Password
+	}
+	if security.BearerToken != nil {
+		secrets.Token = &security.BearerToken.Token
+	}
+	if security.SSLAuth != nil {
+		secrets.Key = &security.SSLAuth.Key
+	}
+	return secrets
+}
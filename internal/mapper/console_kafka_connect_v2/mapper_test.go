@@ -97,3 +97,29 @@ func TestKafkaConnectV2ModelMapping(t *testing.T) {
 		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
 	}
 }
+
+func TestKafkaConnectV2WriteOnlySecrets(t *testing.T) {
+	internal := console.NewKafkaConnectResource("connect-name", "cluster", nil, console.KafkaConnectSpec{
+		DisplayName: "Connect 1",
+		Urls:        "http://localhost:8083",
+		Security:    &console.KafkaConnectSecurity{BasicAuth: &console.KafkaConnectBasicAuth{Username: "some_user"}},
+	})
+	secrets := WriteOnlySecrets{
+		SecurityPasswordWo:        types.StringValue("some_password"),
+		SecurityPasswordWoVersion: types.Int64Value(1),
+		SecurityTokenWo:           types.StringValue("unused"),
+	}
+
+	err := ApplyWriteOnlySecrets(context.Background(), &internal, secrets)
+	assert.NoError(t, err)
+	assert.Equal(t, "some_password", internal.Spec.Security.BasicAuth.Password)
+	assert.Nil(t, internal.Spec.Security.BearerToken)
+
+	ForgetWriteOnlySecrets(&internal, secrets)
+	assert.Equal(t, "", internal.Spec.Security.BasicAuth.Password)
+	assert.Equal(t, "some_user", internal.Spec.Security.BasicAuth.Username)
+
+	internal.Spec.Security.BasicAuth.Password = "some_password"
+	ForgetWriteOnlySecrets(&internal, WriteOnlySecrets{})
+	assert.Equal(t, "some_password", internal.Spec.Security.BasicAuth.Password)
+}
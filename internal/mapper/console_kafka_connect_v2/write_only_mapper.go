@@ -0,0 +1,41 @@
+package console_kafka_connect_v2
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+)
+
+// WriteOnlySecrets holds the write-only variants of the kafka connect server security secrets along with their versions.
+type WriteOnlySecrets = mapper.SecurityWriteOnlySecrets
+
+// ApplyWriteOnlySecrets sets the write-only secrets of the configuration on the kafka connect server to apply.
+func ApplyWriteOnlySecrets(_ context.Context, r *console.KafkaConnectResource, config WriteOnlySecrets) error {
+	securitySecrets(r.Spec.Security).ApplyWriteOnly(config)
+	return nil
+}
+
+// ForgetWriteOnlySecrets clears from a kafka connect server returned by the API the secrets managed through a
+// write-only attribute, i.e. the ones with a version set, so that they are never stored in state and their drift
+// is ignored.
+func ForgetWriteOnlySecrets(r *console.KafkaConnectResource, versions WriteOnlySecrets) {
+	securitySecrets(r.Spec.Security).ForgetWriteOnly(versions)
+}
+
+func securitySecrets(security *console.KafkaConnectSecurity) mapper.SecuritySecrets {
+	var secrets mapper.SecuritySecrets
+	if security == nil {
+		return secrets
+	}
+	if security.BasicAuth != nil {
+		secrets.Password = &security.BasicAuth.Password
+	}
+	if security.BearerToken != nil {
+		secrets.Token = &security.BearerToken.Token
+	}
+	if security.SSLAuth != nil {
+		secrets.Key = &security.SSLAuth.Key
+	}
+	return secrets
+}
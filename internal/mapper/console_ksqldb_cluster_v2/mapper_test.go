@@ -97,3 +97,33 @@ func TestKsqlDBClusterV2ModelMapping(t *testing.T) {
 		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
 	}
 }
+
+func TestKsqlDBClusterV2WriteOnlySecrets(t *testing.T) {
+	internal := console.NewKsqlDBClusterResource("ksqldb-name", "cluster", console.KsqlDBClusterSpec{
+		DisplayName: "KsqlDB 1",
+		Url:         "http://localhost:8088",
+		Security:    &console.KsqlDBClusterSecurity{BearerToken: &console.KsqlDBClusterBearerToken{}},
+	})
+	secrets := WriteOnlySecrets{
+		SecurityTokenWo:        types.StringValue("some_token"),
+		SecurityTokenWoVersion: types.Int64Value(1),
+		SecurityPasswordWo:     types.StringValue("unused"),
+	}
+
+	err := ApplyWriteOnlySecrets(context.Background(), &internal, secrets)
+	assert.NoError(t, err)
+	assert.Equal(t, "some_token", internal.Spec.Security.BearerToken.Token)
+	assert.Nil(t, internal.Spec.Security.BasicAuth)
+
+	ForgetWriteOnlySecrets(&internal, secrets)
+	assert.Equal(t, "", internal.Spec.Security.BearerToken.Token)
+
+	internal.Spec.Security.BearerToken.Token = "some_token"
+	ForgetWriteOnlySecrets(&internal, WriteOnlySecrets{})
+	assert.Equal(t, "some_token", internal.Spec.Security.BearerToken.Token)
+
+	internal.Spec.Security = nil
+	err = ApplyWriteOnlySecrets(context.Background(), &internal, secrets)
+	assert.NoError(t, err)
+	assert.Nil(t, internal.Spec.Security)
+}
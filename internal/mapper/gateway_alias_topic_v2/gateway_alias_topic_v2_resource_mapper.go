@@ -0,0 +1,52 @@
+package gateway_alias_topic_v2
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	gwaliastopics "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_alias_topic_v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TFToInternalModel(r *gwaliastopics.GatewayAliasTopicV2Model) gateway.GatewayAliasTopicResource {
+	return gateway.NewGatewayAliasTopicResource(
+		gateway.GatewayAliasTopicMetadata{
+			Name:     r.Name.ValueString(),
+			VCluster: r.Vcluster.ValueString(),
+		},
+		gateway.GatewayAliasTopicSpec{
+			PhysicalName: r.Spec.PhysicalName.ValueString(),
+		},
+	)
+}
+
+func InternalModelToTerraform(ctx context.Context, r *gateway.GatewayAliasTopicResource) (gwaliastopics.GatewayAliasTopicV2Model, error) {
+	// Configuring default value for vcluster
+	if r.Metadata.VCluster == "" {
+		r.Metadata.VCluster = "passthrough"
+	}
+
+	specValue, diag := gwaliastopics.NewSpecValue(
+		map[string]attr.Type{
+			"physical_name": basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"physical_name": schema.NewStringValue(r.Spec.PhysicalName),
+		},
+	)
+	if diag.HasError() {
+		return gwaliastopics.GatewayAliasTopicV2Model{}, mapper.WrapDiagError(diag, "spec", mapper.IntoTerraform)
+	}
+
+	return gwaliastopics.GatewayAliasTopicV2Model{
+		Name:     types.StringValue(r.Metadata.Name),
+		Vcluster: types.StringValue(r.Metadata.VCluster),
+		// Not part of the Gateway resource, preserved from the configuration by the resource
+		PhysicalCluster: types.StringNull(),
+		Spec:            specValue,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package gateway_alias_topic_v2
+
+import (
+	"context"
+	"testing"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayAliasTopicV2ModelMapping(t *testing.T) {
+
+	ctx := context.Background()
+
+	jsonAliasTopicV2Resource := []byte(test.TestAccTestdata(t, "gateway/alias_topic_v2/api.json"))
+
+	ctlResource := ctlresource.Resource{}
+	err := ctlResource.UnmarshalJSON(jsonAliasTopicV2Resource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "AliasTopic", ctlResource.Kind)
+	assert.Equal(t, "gateway/v2", ctlResource.Version)
+	assert.Equal(t, "orders", ctlResource.Name)
+	assert.Equal(t, map[string]any{"name": "orders", "vCluster": "vcluster1"}, ctlResource.Metadata)
+	assert.Equal(t, jsonAliasTopicV2Resource, ctlResource.Json)
+
+	// convert into internal model
+	internal, err := gateway.NewGatewayAliasTopicResourceFromClientResource(ctlResource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "AliasTopic", internal.Kind)
+	assert.Equal(t, "gateway/v2", internal.ApiVersion)
+	assert.Equal(t, "orders", internal.Metadata.Name)
+	assert.Equal(t, "vcluster1", internal.Metadata.VCluster)
+	assert.Equal(t, "prod.orders.v2", internal.Spec.PhysicalName)
+
+	// convert to terraform model
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("orders"), tfModel.Name)
+	assert.Equal(t, types.StringValue("vcluster1"), tfModel.Vcluster)
+	assert.Equal(t, types.StringNull(), tfModel.PhysicalCluster)
+	assert.Equal(t, types.StringValue("prod.orders.v2"), tfModel.Spec.PhysicalName)
+
+	// convert back to internal model
+	internal2 := TFToInternalModel(&tfModel)
+	assert.Equal(t, internal, internal2)
+
+	// convert back to ctl model
+	ctlResource2, err := internal2.ToClientResource()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	// compare without json
+	if !cmp.Equal(ctlResource, ctlResource2, cmpopts.IgnoreFields(ctlresource.Resource{}, "Json")) {
+		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
+	}
+}
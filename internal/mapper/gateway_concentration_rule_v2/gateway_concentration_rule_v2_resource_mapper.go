@@ -0,0 +1,110 @@
+package gateway_concentration_rule_v2
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	gwconcentrationrules "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_concentration_rule_v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TFToInternalModel(ctx context.Context, r *gwconcentrationrules.GatewayConcentrationRuleV2Model) (gateway.GatewayConcentrationRuleResource, error) {
+	physicalTopics, err := objectValueToPhysicalTopics(ctx, r.Spec.PhysicalTopics)
+	if err != nil {
+		return gateway.GatewayConcentrationRuleResource{}, err
+	}
+
+	return gateway.NewGatewayConcentrationRuleResource(
+		gateway.GatewayConcentrationRuleMetadata{
+			Name:     r.Name.ValueString(),
+			VCluster: r.Vcluster.ValueString(),
+		},
+		gateway.GatewayConcentrationRuleSpec{
+			Pattern:           r.Spec.Pattern.ValueString(),
+			PhysicalTopics:    physicalTopics,
+			AutoManaged:       r.Spec.AutoManaged.ValueBool(),
+			OffsetCorrectness: r.Spec.OffsetCorrectness.ValueBool(),
+		},
+	), nil
+}
+
+func InternalModelToTerraform(ctx context.Context, r *gateway.GatewayConcentrationRuleResource) (gwconcentrationrules.GatewayConcentrationRuleV2Model, error) {
+	// Configuring default value for vcluster
+	if r.Metadata.VCluster == "" {
+		r.Metadata.VCluster = "passthrough"
+	}
+
+	physicalTopics, err := physicalTopicsToObjectValue(ctx, r.Spec.PhysicalTopics)
+	if err != nil {
+		return gwconcentrationrules.GatewayConcentrationRuleV2Model{}, err
+	}
+
+	specValue, diag := gwconcentrationrules.NewSpecValue(
+		map[string]attr.Type{
+			"pattern":            basetypes.StringType{},
+			"physical_topics":    physicalTopics.Type(ctx),
+			"auto_managed":       basetypes.BoolType{},
+			"offset_correctness": basetypes.BoolType{},
+		},
+		map[string]attr.Value{
+			"pattern":            schema.NewStringValue(r.Spec.Pattern),
+			"physical_topics":    physicalTopics,
+			"auto_managed":       types.BoolValue(r.Spec.AutoManaged),
+			"offset_correctness": types.BoolValue(r.Spec.OffsetCorrectness),
+		},
+	)
+	if diag.HasError() {
+		return gwconcentrationrules.GatewayConcentrationRuleV2Model{}, mapper.WrapDiagError(diag, "spec", mapper.IntoTerraform)
+	}
+
+	return gwconcentrationrules.GatewayConcentrationRuleV2Model{
+		Name:     types.StringValue(r.Metadata.Name),
+		Vcluster: types.StringValue(r.Metadata.VCluster),
+		Spec:     specValue,
+	}, nil
+}
+
+func objectValueToPhysicalTopics(ctx context.Context, r basetypes.ObjectValue) (gateway.GatewayConcentrationRulePhysicalTopics, error) {
+	if r.IsNull() || r.IsUnknown() {
+		return gateway.GatewayConcentrationRulePhysicalTopics{}, nil
+	}
+
+	physicalTopics, diag := gwconcentrationrules.NewPhysicalTopicsValue(r.AttributeTypes(ctx), r.Attributes())
+	if diag.HasError() {
+		return gateway.GatewayConcentrationRulePhysicalTopics{}, mapper.WrapDiagError(diag, "physical_topics", mapper.FromTerraform)
+	}
+
+	return gateway.GatewayConcentrationRulePhysicalTopics{
+		Delete:        physicalTopics.Delete.ValueString(),
+		Compact:       physicalTopics.Compact.ValueString(),
+		DeleteCompact: physicalTopics.DeleteCompact.ValueString(),
+	}, nil
+}
+
+func physicalTopicsToObjectValue(ctx context.Context, r gateway.GatewayConcentrationRulePhysicalTopics) (basetypes.ObjectValue, error) {
+	physicalTopics, diag := gwconcentrationrules.NewPhysicalTopicsValue(
+		map[string]attr.Type{
+			"delete":         basetypes.StringType{},
+			"compact":        basetypes.StringType{},
+			"delete_compact": basetypes.StringType{},
+		},
+		map[string]attr.Value{
+			"delete":         schema.NewStringValue(r.Delete),
+			"compact":        schema.NewStringValue(r.Compact),
+			"delete_compact": schema.NewStringValue(r.DeleteCompact),
+		},
+	)
+	if diag.HasError() {
+		return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "physical_topics", mapper.IntoTerraform)
+	}
+
+	object, diag := physicalTopics.ToObjectValue(ctx)
+	if diag.HasError() {
+		return basetypes.ObjectValue{}, mapper.WrapDiagError(diag, "physical_topics", mapper.IntoTerraform)
+	}
+	return object, nil
+}
@@ -0,0 +1,81 @@
+package gateway_concentration_rule_v2
+
+import (
+	"context"
+	"testing"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayConcentrationRuleV2ModelMapping(t *testing.T) {
+
+	ctx := context.Background()
+
+	jsonConcentrationRuleV2Resource := []byte(test.TestAccTestdata(t, "gateway/concentration_rule_v2/api.json"))
+
+	ctlResource := ctlresource.Resource{}
+	err := ctlResource.UnmarshalJSON(jsonConcentrationRuleV2Resource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "ConcentrationRule", ctlResource.Kind)
+	assert.Equal(t, "gateway/v2", ctlResource.Version)
+	assert.Equal(t, "low-throughput", ctlResource.Name)
+	assert.Equal(t, map[string]any{"name": "low-throughput", "vCluster": "vcluster1"}, ctlResource.Metadata)
+	assert.Equal(t, jsonConcentrationRuleV2Resource, ctlResource.Json)
+
+	// convert into internal model
+	internal, err := gateway.NewGatewayConcentrationRuleResourceFromClientResource(ctlResource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "ConcentrationRule", internal.Kind)
+	assert.Equal(t, "gateway/v2", internal.ApiVersion)
+	assert.Equal(t, "low-throughput", internal.Metadata.Name)
+	assert.Equal(t, "vcluster1", internal.Metadata.VCluster)
+	assert.Equal(t, "orders-.*", internal.Spec.Pattern)
+	assert.Equal(t, gateway.GatewayConcentrationRulePhysicalTopics{Delete: "orders-concentrated", Compact: "orders-concentrated-compact"}, internal.Spec.PhysicalTopics)
+	assert.True(t, internal.Spec.AutoManaged)
+	assert.False(t, internal.Spec.OffsetCorrectness)
+
+	// convert to terraform model
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("low-throughput"), tfModel.Name)
+	assert.Equal(t, types.StringValue("vcluster1"), tfModel.Vcluster)
+	assert.Equal(t, types.StringValue("orders-.*"), tfModel.Spec.Pattern)
+	assert.Equal(t, types.StringValue("orders-concentrated"), tfModel.Spec.PhysicalTopics.Attributes()["delete"])
+	assert.Equal(t, types.StringNull(), tfModel.Spec.PhysicalTopics.Attributes()["delete_compact"])
+	assert.Equal(t, types.BoolValue(true), tfModel.Spec.AutoManaged)
+	assert.Equal(t, types.BoolValue(false), tfModel.Spec.OffsetCorrectness)
+
+	// convert back to internal model
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, internal, internal2)
+
+	// convert back to ctl model
+	ctlResource2, err := internal2.ToClientResource()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	// compare without json
+	if !cmp.Equal(ctlResource, ctlResource2, cmpopts.IgnoreFields(ctlresource.Resource{}, "Json")) {
+		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
+	}
+}
@@ -0,0 +1,129 @@
+package gateway_group_v2
+
+import (
+	"context"
+
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	gwgroups "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_group_v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Default virtual cluster of the members.
+const defaultVCluster = "passthrough"
+
+func TFToInternalModel(ctx context.Context, r *gwgroups.GatewayGroupV2Model) (gateway.GatewayGroupResource, error) {
+	members, err := setValueToMembers(ctx, r.Spec.Members)
+	if err != nil {
+		return gateway.GatewayGroupResource{}, err
+	}
+
+	externalGroups, diag := schema.SetValueToStringArray(ctx, r.Spec.ExternalGroups)
+	if diag.HasError() {
+		return gateway.GatewayGroupResource{}, mapper.WrapDiagError(diag, "external_groups", mapper.FromTerraform)
+	}
+
+	return gateway.NewGatewayGroupResource(
+		gateway.GatewayGroupMetadata{
+			Name: r.Name.ValueString(),
+		},
+		gateway.GatewayGroupSpec{
+			Members:        members,
+			ExternalGroups: externalGroups,
+		},
+	), nil
+}
+
+func InternalModelToTerraform(ctx context.Context, r *gateway.GatewayGroupResource) (gwgroups.GatewayGroupV2Model, error) {
+	members, err := membersToSetValue(ctx, r.Spec.Members)
+	if err != nil {
+		return gwgroups.GatewayGroupV2Model{}, err
+	}
+
+	externalGroups := types.SetNull(basetypes.StringType{})
+	if len(r.Spec.ExternalGroups) > 0 {
+		externalGroupsSet, diag := schema.StringArrayToSetValue(r.Spec.ExternalGroups)
+		if diag.HasError() {
+			return gwgroups.GatewayGroupV2Model{}, mapper.WrapDiagError(diag, "external_groups", mapper.IntoTerraform)
+		}
+		externalGroups = externalGroupsSet
+	}
+
+	specValue, diag := gwgroups.NewSpecValue(
+		map[string]attr.Type{
+			"members":         members.Type(ctx),
+			"external_groups": externalGroups.Type(ctx),
+		},
+		map[string]attr.Value{
+			"members":         members,
+			"external_groups": externalGroups,
+		},
+	)
+	if diag.HasError() {
+		return gwgroups.GatewayGroupV2Model{}, mapper.WrapDiagError(diag, "spec", mapper.IntoTerraform)
+	}
+
+	return gwgroups.GatewayGroupV2Model{
+		Name: types.StringValue(r.Metadata.Name),
+		Spec: specValue,
+	}, nil
+}
+
+func setValueToMembers(ctx context.Context, set basetypes.SetValue) ([]gateway.GatewayGroupMember, error) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	var membersValue []gwgroups.MembersValue
+	diag := set.ElementsAs(ctx, &membersValue, false)
+	if diag.HasError() {
+		return nil, mapper.WrapDiagError(diag, "members", mapper.FromTerraform)
+	}
+
+	members := make([]gateway.GatewayGroupMember, 0, len(membersValue))
+	for _, m := range membersValue {
+		members = append(members, gateway.GatewayGroupMember{
+			VCluster: m.Vcluster.ValueString(),
+			Name:     m.Name.ValueString(),
+		})
+	}
+	return members, nil
+}
+
+func membersToSetValue(ctx context.Context, members []gateway.GatewayGroupMember) (basetypes.SetValue, error) {
+	if len(members) == 0 {
+		return types.SetNull(gwgroups.MembersValue{}.Type(ctx)), nil
+	}
+
+	var tfMembers []attr.Value
+	for _, m := range members {
+		vcluster := m.VCluster
+		if vcluster == "" {
+			vcluster = defaultVCluster
+		}
+
+		member, diag := gwgroups.NewMembersValue(
+			map[string]attr.Type{
+				"vcluster": basetypes.StringType{},
+				"name":     basetypes.StringType{},
+			},
+			map[string]attr.Value{
+				"vcluster": types.StringValue(vcluster),
+				"name":     types.StringValue(m.Name),
+			},
+		)
+		if diag.HasError() {
+			return basetypes.SetValue{}, mapper.WrapDiagError(diag, "members", mapper.IntoTerraform)
+		}
+		tfMembers = append(tfMembers, member)
+	}
+
+	set, diag := types.SetValue(gwgroups.MembersValue{}.Type(ctx), tfMembers)
+	if diag.HasError() {
+		return basetypes.SetValue{}, mapper.WrapDiagError(diag, "members", mapper.IntoTerraform)
+	}
+	return set, nil
+}
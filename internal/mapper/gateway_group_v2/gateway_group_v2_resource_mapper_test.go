@@ -0,0 +1,103 @@
+package gateway_group_v2
+
+import (
+	"context"
+	"testing"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayGroupV2ModelMapping(t *testing.T) {
+
+	ctx := context.Background()
+
+	jsonGroupV2Resource := []byte(test.TestAccTestdata(t, "gateway/group_v2/api.json"))
+
+	ctlResource := ctlresource.Resource{}
+	err := ctlResource.UnmarshalJSON(jsonGroupV2Resource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "GatewayGroup", ctlResource.Kind)
+	assert.Equal(t, "gateway/v2", ctlResource.Version)
+	assert.Equal(t, "group1", ctlResource.Name)
+	assert.Equal(t, map[string]any{"name": "group1"}, ctlResource.Metadata)
+	assert.Equal(t, jsonGroupV2Resource, ctlResource.Json)
+
+	// convert into internal model
+	internal, err := gateway.NewGatewayGroupResourceFromClientResource(ctlResource)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "GatewayGroup", internal.Kind)
+	assert.Equal(t, "gateway/v2", internal.ApiVersion)
+	assert.Equal(t, "group1", internal.Metadata.Name)
+	assert.Equal(t, []gateway.GatewayGroupMember{{VCluster: "vcluster1", Name: "user1"}, {VCluster: "vcluster1", Name: "user2"}}, internal.Spec.Members)
+	assert.Equal(t, []string{"ldap-group"}, internal.Spec.ExternalGroups)
+
+	// convert to terraform model
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, types.StringValue("group1"), tfModel.Name)
+	assert.Len(t, tfModel.Spec.Members.Elements(), 2)
+	assert.Len(t, tfModel.Spec.ExternalGroups.Elements(), 1)
+
+	// convert back to internal model
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, "GatewayGroup", internal2.Kind)
+	assert.Equal(t, "gateway/v2", internal2.ApiVersion)
+	assert.Equal(t, "group1", internal2.Metadata.Name)
+	assert.ElementsMatch(t, internal.Spec.Members, internal2.Spec.Members)
+	assert.Equal(t, []string{"ldap-group"}, internal2.Spec.ExternalGroups)
+
+	// convert back to ctl model
+	ctlResource2, err := internal2.ToClientResource()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	// compare without json
+	if !cmp.Equal(ctlResource, ctlResource2, cmpopts.IgnoreFields(ctlresource.Resource{}, "Json")) {
+		t.Errorf("expected %+v, got %+v", ctlResource, ctlResource2)
+	}
+}
+
+func TestGatewayGroupV2DefaultVCluster(t *testing.T) {
+	ctx := context.Background()
+
+	internal := gateway.NewGatewayGroupResource(
+		gateway.GatewayGroupMetadata{Name: "group1"},
+		gateway.GatewayGroupSpec{Members: []gateway.GatewayGroupMember{{Name: "user1"}}},
+	)
+
+	tfModel, err := InternalModelToTerraform(ctx, &internal)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Len(t, tfModel.Spec.Members.Elements(), 1)
+	assert.True(t, tfModel.Spec.ExternalGroups.IsNull())
+
+	internal2, err := TFToInternalModel(ctx, &tfModel)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, []gateway.GatewayGroupMember{{VCluster: "passthrough", Name: "user1"}}, internal2.Spec.Members)
+	assert.Nil(t, internal2.Spec.ExternalGroups)
+}
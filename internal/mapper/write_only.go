@@ -0,0 +1,55 @@
+package mapper
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SecurityWriteOnlySecrets holds the write-only variants of the secrets of a basic auth, bearer token or SSL auth
+// security block along with their versions.
+// Secret values are only available in the configuration, they are always null in plan and state.
+type SecurityWriteOnlySecrets struct {
+	SecurityPasswordWo        types.String `tfsdk:"security_password_wo"`
+	SecurityPasswordWoVersion types.Int64  `tfsdk:"security_password_wo_version"`
+	SecurityTokenWo           types.String `tfsdk:"security_token_wo"`
+	SecurityTokenWoVersion    types.Int64  `tfsdk:"security_token_wo_version"`
+	SecuritySslKeyWo          types.String `tfsdk:"security_ssl_key_wo"`
+	SecuritySslKeyWoVersion   types.Int64  `tfsdk:"security_ssl_key_wo_version"`
+}
+
+// SecuritySecrets points to the secrets of a security block, nil for the authentications not in use.
+type SecuritySecrets struct {
+	Password *string
+	Token    *string
+	Key      *string
+}
+
+// ApplyWriteOnly sets the write-only secrets of the configuration on the security block to apply.
+func (s SecuritySecrets) ApplyWriteOnly(config SecurityWriteOnlySecrets) {
+	if s.Password != nil && isSet(config.SecurityPasswordWo) {
+		*s.Password = config.SecurityPasswordWo.ValueString()
+	}
+	if s.Token != nil && isSet(config.SecurityTokenWo) {
+		*s.Token = config.SecurityTokenWo.ValueString()
+	}
+	if s.Key != nil && isSet(config.SecuritySslKeyWo) {
+		*s.Key = config.SecuritySslKeyWo.ValueString()
+	}
+}
+
+// ForgetWriteOnly clears from a security block returned by the API the secrets managed through a write-only
+// attribute, i.e. the ones with a version set.
+func (s SecuritySecrets) ForgetWriteOnly(versions SecurityWriteOnlySecrets) {
+	if s.Password != nil && !versions.SecurityPasswordWoVersion.IsNull() {
+		*s.Password = ""
+	}
+	if s.Token != nil && !versions.SecurityTokenWoVersion.IsNull() {
+		*s.Token = ""
+	}
+	if s.Key != nil && !versions.SecuritySslKeyWoVersion.IsNull() {
+		*s.Key = ""
+	}
+}
+
+// isSet tells whether a write-only secret is set in the configuration. It mirrors schema.AttrIsSet, which can't be
+// used here as the schema package depends on the mapper.
+func isSet(secret types.String) bool {
+	return !secret.IsNull() && !secret.IsUnknown()
+}
@@ -0,0 +1,108 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	model "github.com/conduktor/terraform-provider-conduktor/internal/model"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const AlertV3Kind = "Alert"
+const AlertV3ApiVersion = "v3"
+
+// AlertConsoleMetadata holds the name of the alert and its owner, one of an application instance, a group or a user.
+type AlertConsoleMetadata struct {
+	Name        string `json:"name"`
+	AppInstance string `json:"appInstance,omitempty"`
+	Group       string `json:"group,omitempty"`
+	User        string `json:"user,omitempty"`
+	// Computed by Console.
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+func (r AlertConsoleMetadata) String() string {
+	return fmt.Sprintf(`name: %s, appInstance: %s, group: %s, user: %s`, r.Name, r.AppInstance, r.Group, r.User)
+}
+
+// AlertDestination is where the alert is sent, the fields set depending on its type.
+type AlertDestination struct {
+	Type string `json:"type"`
+	// Slack
+	Channel string `json:"channel,omitempty"`
+	// Teams and Webhook
+	Url string `json:"url,omitempty"`
+	// Email
+	Emails []string `json:"emails,omitempty"`
+	// Webhook
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+type AlertConsoleSpec struct {
+	Cluster           string           `json:"cluster"`
+	Type              string           `json:"type"`
+	TopicName         string           `json:"topicName,omitempty"`
+	ConsumerGroupName string           `json:"consumerGroupName,omitempty"`
+	ConnectName       string           `json:"connectName,omitempty"`
+	ConnectorName     string           `json:"connectorName,omitempty"`
+	Metric            string           `json:"metric"`
+	Operator          string           `json:"operator"`
+	Threshold         int64            `json:"threshold"`
+	Description       string           `json:"description,omitempty"`
+	Disable           bool             `json:"disable"`
+	Destination       AlertDestination `json:"destination"`
+	// Computed by Console.
+	PromQl string `json:"promQl,omitempty"`
+}
+
+type AlertConsoleResource struct {
+	Kind       string               `json:"kind"`
+	ApiVersion string               `json:"apiVersion"`
+	Metadata   AlertConsoleMetadata `json:"metadata"`
+	Spec       AlertConsoleSpec     `json:"spec"`
+}
+
+func NewAlertConsoleResource(metadata AlertConsoleMetadata, spec AlertConsoleSpec) AlertConsoleResource {
+	return AlertConsoleResource{
+		Kind:       AlertV3Kind,
+		ApiVersion: AlertV3ApiVersion,
+		Metadata:   metadata,
+		Spec:       spec,
+	}
+}
+
+func (r *AlertConsoleResource) ToClientResource() (ctlresource.Resource, error) {
+	return model.ToClientResource(r)
+}
+
+func (r *AlertConsoleResource) FromClientResource(cliResource ctlresource.Resource) error {
+	err := jsoniter.Unmarshal(cliResource.Json, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *AlertConsoleResource) FromRawJsonInterface(jsonInterface any) error {
+	jsonData, err := json.Marshal(jsonInterface)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(jsonData, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAlertConsoleResourceFromClientResource(cliResource ctlresource.Resource) (AlertConsoleResource, error) {
+	var consoleResource AlertConsoleResource
+	err := consoleResource.FromClientResource(cliResource)
+	if err != nil {
+		return AlertConsoleResource{}, err
+	}
+	return consoleResource, nil
+}
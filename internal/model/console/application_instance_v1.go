@@ -3,6 +3,7 @@ package console
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	ctlresource "github.com/conduktor/ctl/resource"
 	model "github.com/conduktor/terraform-provider-conduktor/internal/model"
@@ -90,3 +91,32 @@ func NewApplicationInstanceConsoleResourceFromClientResource(cliResource ctlreso
 	}
 	return consoleResource, nil
 }
+
+// FindOwner returns the application instance owning the resource of the given type and name on a cluster, nil if
+// none does. Literal ownership wins over prefixed ones, then the longest prefix wins.
+func FindOwner(instances []ApplicationInstanceConsoleResource, cluster, resourceType, name string) *ApplicationInstanceConsoleResource {
+	var owner *ApplicationInstanceConsoleResource
+	bestPrefix := -1
+	for i := range instances {
+		instance := &instances[i]
+		if instance.Spec.Cluster != cluster {
+			continue
+		}
+		for _, resource := range instance.Spec.Resources {
+			if resource.Type != resourceType {
+				continue
+			}
+			switch resource.PatternType {
+			case "LITERAL":
+				if resource.Name == name {
+					return instance
+				}
+			case "PREFIXED":
+				if strings.HasPrefix(name, resource.Name) && len(resource.Name) > bestPrefix {
+					owner, bestPrefix = instance, len(resource.Name)
+				}
+			}
+		}
+	}
+	return owner
+}
@@ -0,0 +1,28 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindOwner(t *testing.T) {
+	instance := func(name, cluster string, resources ...ResourceWithOwnership) ApplicationInstanceConsoleResource {
+		return NewApplicationInstanceConsoleResource(name, "app", ApplicationInstanceConsoleSpec{Cluster: cluster, Resources: resources})
+	}
+	topic := func(name, patternType string) ResourceWithOwnership {
+		return ResourceWithOwnership{Type: "TOPIC", Name: name, PatternType: patternType}
+	}
+	instances := []ApplicationInstanceConsoleResource{
+		instance("other-cluster", "other", topic("website-analytics.clicks", "LITERAL")),
+		instance("short-prefix", "kafka-cluster", topic("website", "PREFIXED")),
+		instance("long-prefix", "kafka-cluster", topic("website-analytics.", "PREFIXED"), ResourceWithOwnership{Type: "CONSUMER_GROUP", Name: "website", PatternType: "PREFIXED"}),
+		instance("literal", "kafka-cluster", topic("website-analytics.clicks", "LITERAL")),
+	}
+
+	assert.Equal(t, "literal", FindOwner(instances, "kafka-cluster", "TOPIC", "website-analytics.clicks").Metadata.Name)
+	assert.Equal(t, "long-prefix", FindOwner(instances, "kafka-cluster", "TOPIC", "website-analytics.views").Metadata.Name)
+	assert.Equal(t, "short-prefix", FindOwner(instances, "kafka-cluster", "TOPIC", "website-orders").Metadata.Name)
+	assert.Equal(t, "other-cluster", FindOwner(instances, "other", "TOPIC", "website-analytics.clicks").Metadata.Name)
+	assert.Nil(t, FindOwner(instances, "kafka-cluster", "TOPIC", "payments"))
+}
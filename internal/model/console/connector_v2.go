@@ -34,6 +34,33 @@ type ConnectorConsoleSpec struct {
 	Config map[string]string `json:"config"`
 }
 
+// ConnectorTaskStatus is the status of a connector task.
+type ConnectorTaskStatus struct {
+	Id       int64  `json:"id"`
+	State    string `json:"state"`
+	WorkerId string `json:"workerId,omitempty"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+// ConnectorStatus is the status of a connector and its tasks, as reported by Kafka Connect through Console.
+type ConnectorStatus struct {
+	ConnectorState string                `json:"connectorState"`
+	WorkerId       string                `json:"workerId,omitempty"`
+	Trace          string                `json:"trace,omitempty"`
+	Tasks          []ConnectorTaskStatus `json:"tasks"`
+}
+
+// FailedTasks returns the ids of the tasks in the FAILED state.
+func (s *ConnectorStatus) FailedTasks() []int64 {
+	var failed []int64
+	for _, task := range s.Tasks {
+		if task.State == "FAILED" {
+			failed = append(failed, task.Id)
+		}
+	}
+	return failed
+}
+
 type ConnectorConsoleResource struct {
 	Kind       string                   `json:"kind"`
 	ApiVersion string                   `json:"apiVersion"`
@@ -0,0 +1,85 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	model "github.com/conduktor/terraform-provider-conduktor/internal/model"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const DataMaskingPolicyV1Kind = "DataMaskingPolicy"
+const DataMaskingPolicyV1ApiVersion = "v1"
+
+type DataMaskingPolicyConsoleMetadata struct {
+	Name string `json:"name"`
+}
+
+func (r DataMaskingPolicyConsoleMetadata) String() string {
+	return fmt.Sprintf(`name: %s`, r.Name)
+}
+
+type DataMaskingRule struct {
+	Type          string `json:"type"`
+	MaskingChar   string `json:"maskingChar,omitempty"`
+	NumberOfChars int64  `json:"numberOfChars,omitempty"`
+}
+
+type DataMaskingPolicyConsoleSpec struct {
+	Description    string          `json:"description,omitempty"`
+	Fields         []string        `json:"fields"`
+	Rule           DataMaskingRule `json:"rule"`
+	ExemptedGroups []string        `json:"exemptedGroups"`
+}
+
+type DataMaskingPolicyConsoleResource struct {
+	Kind       string                           `json:"kind"`
+	ApiVersion string                           `json:"apiVersion"`
+	Metadata   DataMaskingPolicyConsoleMetadata `json:"metadata"`
+	Spec       DataMaskingPolicyConsoleSpec     `json:"spec"`
+}
+
+func NewDataMaskingPolicyConsoleResource(name string, spec DataMaskingPolicyConsoleSpec) DataMaskingPolicyConsoleResource {
+	return DataMaskingPolicyConsoleResource{
+		Kind:       DataMaskingPolicyV1Kind,
+		ApiVersion: DataMaskingPolicyV1ApiVersion,
+		Metadata: DataMaskingPolicyConsoleMetadata{
+			Name: name,
+		},
+		Spec: spec,
+	}
+}
+
+func (r *DataMaskingPolicyConsoleResource) ToClientResource() (ctlresource.Resource, error) {
+	return model.ToClientResource(r)
+}
+
+func (r *DataMaskingPolicyConsoleResource) FromClientResource(cliResource ctlresource.Resource) error {
+	err := jsoniter.Unmarshal(cliResource.Json, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *DataMaskingPolicyConsoleResource) FromRawJsonInterface(jsonInterface any) error {
+	jsonData, err := json.Marshal(jsonInterface)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(jsonData, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewDataMaskingPolicyConsoleResourceFromClientResource(cliResource ctlresource.Resource) (DataMaskingPolicyConsoleResource, error) {
+	var consoleResource DataMaskingPolicyConsoleResource
+	err := consoleResource.FromClientResource(cliResource)
+	if err != nil {
+		return DataMaskingPolicyConsoleResource{}, err
+	}
+	return consoleResource, nil
+}
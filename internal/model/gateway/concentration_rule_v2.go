@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	model "github.com/conduktor/terraform-provider-conduktor/internal/model"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const GatewayConcentrationRuleV2Kind = "ConcentrationRule"
+const GatewayConcentrationRuleV2ApiVersion = "gateway/v2"
+
+type GatewayConcentrationRuleMetadata struct {
+	Name     string `json:"name"`
+	VCluster string `json:"vCluster,omitempty"`
+}
+
+func (r GatewayConcentrationRuleMetadata) String() string {
+	return fmt.Sprintf(`name: %s, vCluster: %s`, r.Name, r.VCluster)
+}
+
+// GatewayConcentrationRulePhysicalTopics are the physical topics concentrating the topics matching a rule, by cleanup policy.
+type GatewayConcentrationRulePhysicalTopics struct {
+	Delete        string `json:"delete"`
+	Compact       string `json:"compact,omitempty"`
+	DeleteCompact string `json:"deleteCompact,omitempty"`
+}
+
+type GatewayConcentrationRuleSpec struct {
+	Pattern           string                                 `json:"pattern"`
+	PhysicalTopics    GatewayConcentrationRulePhysicalTopics `json:"physicalTopics"`
+	AutoManaged       bool                                   `json:"autoManaged"`
+	OffsetCorrectness bool                                   `json:"offsetCorrectness"`
+}
+
+type GatewayConcentrationRuleResource struct {
+	Kind       string                           `json:"kind"`
+	ApiVersion string                           `json:"apiVersion"`
+	Metadata   GatewayConcentrationRuleMetadata `json:"metadata"`
+	Spec       GatewayConcentrationRuleSpec     `json:"spec"`
+}
+
+func NewGatewayConcentrationRuleResource(metadata GatewayConcentrationRuleMetadata, spec GatewayConcentrationRuleSpec) GatewayConcentrationRuleResource {
+	return GatewayConcentrationRuleResource{
+		Kind:       GatewayConcentrationRuleV2Kind,
+		ApiVersion: GatewayConcentrationRuleV2ApiVersion,
+		Metadata:   metadata,
+		Spec:       spec,
+	}
+}
+
+func (r *GatewayConcentrationRuleResource) ToClientResource() (ctlresource.Resource, error) {
+	return model.ToClientResource(r)
+}
+
+func (r *GatewayConcentrationRuleResource) FromClientResource(cliResource ctlresource.Resource) error {
+	err := jsoniter.Unmarshal(cliResource.Json, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *GatewayConcentrationRuleResource) FromRawJsonInterface(jsonInterface any) error {
+	jsonData, err := json.Marshal(jsonInterface)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(jsonData, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewGatewayConcentrationRuleResourceFromClientResource(cliResource ctlresource.Resource) (GatewayConcentrationRuleResource, error) {
+	var gatewayResource GatewayConcentrationRuleResource
+	err := gatewayResource.FromClientResource(cliResource)
+	if err != nil {
+		return GatewayConcentrationRuleResource{}, err
+	}
+	return gatewayResource, nil
+}
@@ -0,0 +1,84 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	model "github.com/conduktor/terraform-provider-conduktor/internal/model"
+
+	ctlresource "github.com/conduktor/ctl/resource"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const GatewayGroupV2Kind = "GatewayGroup"
+const GatewayGroupV2ApiVersion = "gateway/v2"
+
+// GatewayGroupMetadata identifies a group by its name alone, groups are not scoped to a virtual cluster but their
+// members are.
+type GatewayGroupMetadata struct {
+	Name string `json:"name"`
+}
+
+func (r GatewayGroupMetadata) String() string {
+	return fmt.Sprintf(`name: %s`, r.Name)
+}
+
+// GatewayGroupMember identifies a service account member of a group.
+type GatewayGroupMember struct {
+	VCluster string `json:"vCluster,omitempty"`
+	Name     string `json:"name"`
+}
+
+type GatewayGroupSpec struct {
+	Members        []GatewayGroupMember `json:"members,omitempty"`
+	ExternalGroups []string             `json:"externalGroups,omitempty"`
+}
+
+type GatewayGroupResource struct {
+	Kind       string               `json:"kind"`
+	ApiVersion string               `json:"apiVersion"`
+	Metadata   GatewayGroupMetadata `json:"metadata"`
+	Spec       GatewayGroupSpec     `json:"spec"`
+}
+
+func NewGatewayGroupResource(metadata GatewayGroupMetadata, spec GatewayGroupSpec) GatewayGroupResource {
+	return GatewayGroupResource{
+		Kind:       GatewayGroupV2Kind,
+		ApiVersion: GatewayGroupV2ApiVersion,
+		Metadata:   metadata,
+		Spec:       spec,
+	}
+}
+
+func (r *GatewayGroupResource) ToClientResource() (ctlresource.Resource, error) {
+	return model.ToClientResource(r)
+}
+
+func (r *GatewayGroupResource) FromClientResource(cliResource ctlresource.Resource) error {
+	err := jsoniter.Unmarshal(cliResource.Json, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *GatewayGroupResource) FromRawJsonInterface(jsonInterface any) error {
+	jsonData, err := json.Marshal(jsonInterface)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(jsonData, r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewGatewayGroupResourceFromClientResource(cliResource ctlresource.Resource) (GatewayGroupResource, error) {
+	var gatewayResource GatewayGroupResource
+	err := gatewayResource.FromClientResource(cliResource)
+	if err != nil {
+		return GatewayGroupResource{}, err
+	}
+	return gatewayResource, nil
+}
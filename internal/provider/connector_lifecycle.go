@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_connector_v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func connectorV2ApiStatusPath(cluster, connectCluster, connectorName string) string {
+	return connectorV2ApiGetPath(cluster, connectCluster, connectorName) + "/status"
+}
+
+func connectorV2ApiActionPath(cluster, connectCluster, connectorName, action string) string {
+	return connectorV2ApiGetPath(cluster, connectCluster, connectorName) + "/" + action
+}
+
+func connectorV2ApiTaskRestartPath(cluster, connectCluster, connectorName string, taskId int64) string {
+	return fmt.Sprintf("%s/task/%d/restart", connectorV2ApiGetPath(cluster, connectCluster, connectorName), taskId)
+}
+
+// readConnectorStatus returns the status of a connector and its tasks, or nil if Console doesn't report it,
+// for instance for a connector just created and not yet started by Kafka Connect.
+func readConnectorStatus(ctx context.Context, apiClient *client.Client, cluster, connectCluster, connectorName string) (*console.ConnectorStatus, error) {
+	get, err := apiClient.Describe(ctx, connectorV2ApiStatusPath(cluster, connectCluster, connectorName))
+	if err != nil {
+		return nil, err
+	}
+	if len(get) == 0 {
+		return nil, nil
+	}
+
+	var status console.ConnectorStatus
+	err = jsoniter.Unmarshal(get, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// connectorStateAction returns the Console action moving a connector from its current state to the desired one,
+// or an empty string if there is nothing to do. Failed or restarting connectors are left to restarts.
+func connectorStateAction(current, desired string) string {
+	if current == desired {
+		return ""
+	}
+	switch desired {
+	case "PAUSED":
+		return "pause"
+	case "STOPPED":
+		return "stop"
+	case "RUNNING":
+		if current == "PAUSED" || current == "STOPPED" {
+			return "resume"
+		}
+	}
+	return ""
+}
+
+// reconcileConnectorState pauses, resumes or stops the connector for it to reach the desired state.
+func reconcileConnectorState(ctx context.Context, apiClient *client.Client, connector *console.ConnectorConsoleResource, status *console.ConnectorStatus, desired string) error {
+	current := "RUNNING"
+	if status != nil {
+		current = status.ConnectorState
+	}
+
+	action := connectorStateAction(current, desired)
+	if action == "" {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Connector %s is %s, sending %s action to reach %s state", connector.Metadata.Name, current, action, desired))
+	_, err := apiClient.Post(ctx, connectorV2ApiActionPath(connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name, action))
+	return err
+}
+
+// restartConnector restarts the connector and its failed tasks.
+func restartConnector(ctx context.Context, apiClient *client.Client, connector *console.ConnectorConsoleResource, status *console.ConnectorStatus) error {
+	tflog.Info(ctx, fmt.Sprintf("Restarting connector %s", connector.Metadata.Name))
+	_, err := apiClient.Post(ctx, connectorV2ApiActionPath(connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name, "restart"))
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return nil
+	}
+
+	for _, taskId := range status.FailedTasks() {
+		tflog.Info(ctx, fmt.Sprintf("Restarting failed task %d of connector %s", taskId, connector.Metadata.Name))
+		_, err = apiClient.Post(ctx, connectorV2ApiTaskRestartPath(connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name, taskId))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectorWait is the wait_for_running configuration of a connector.
+type connectorWait struct {
+	timeout      time.Duration
+	pollInterval time.Duration
+	// Minimum number of running tasks, all tasks if nil.
+	minRunningTasks *int64
+}
+
+// connectorWaitFromModel returns the wait_for_running configuration, or nil if the connector is not waited for.
+func connectorWaitFromModel(v schema.WaitForRunningValue) (*connectorWait, error) {
+	if !schemaUtils.AttrIsSet(v) {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(v.Timeout.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait_for_running timeout %q: %s", v.Timeout.ValueString(), err)
+	}
+	pollInterval, err := time.ParseDuration(v.PollInterval.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait_for_running poll_interval %q: %s", v.PollInterval.ValueString(), err)
+	}
+	return &connectorWait{timeout: timeout, pollInterval: pollInterval, minRunningTasks: v.MinRunningTasks.ValueInt64Pointer()}, nil
+}
+
+// connectorRunning returns whether the connector and enough of its tasks are running. Without minimum, all the tasks
+// must be running and there must be at least one.
+func connectorRunning(status *console.ConnectorStatus, minRunningTasks *int64) bool {
+	if status == nil || status.ConnectorState != "RUNNING" {
+		return false
+	}
+
+	var running int64
+	for _, task := range status.Tasks {
+		if task.State == "RUNNING" {
+			running++
+		}
+	}
+	if minRunningTasks != nil {
+		return running >= *minRunningTasks
+	}
+	return running > 0 && running == int64(len(status.Tasks))
+}
+
+// describeConnectorStatus describes the connector and task states, along with their failure traces.
+func describeConnectorStatus(status *console.ConnectorStatus) string {
+	if status == nil {
+		return "no status reported by Kafka Connect"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "connector is %s", status.ConnectorState)
+	if status.Trace != "" {
+		fmt.Fprintf(&b, "\n%s", status.Trace)
+	}
+	if len(status.Tasks) == 0 {
+		b.WriteString(", no task")
+	}
+	for _, task := range status.Tasks {
+		fmt.Fprintf(&b, "\ntask %d is %s", task.Id, task.State)
+		if task.Trace != "" {
+			fmt.Fprintf(&b, "\n%s", task.Trace)
+		}
+	}
+	return b.String()
+}
+
+// waitForConnectorRunning polls the connector status until the connector and its tasks are running, returning the
+// last status read. An error describing the last status is returned if they are not running within the wait timeout.
+func waitForConnectorRunning(ctx context.Context, apiClient *client.Client, connector *console.ConnectorConsoleResource, wait *connectorWait) (*console.ConnectorStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, wait.timeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("Polling connector %s status every %s for %s", connector.Metadata.Name, wait.pollInterval, wait.timeout))
+	start := time.Now()
+	var last *console.ConnectorStatus
+	for attempt := 1; ; attempt++ {
+		status, err := readConnectorStatus(ctx, apiClient, connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name)
+		if err != nil && ctx.Err() == nil {
+			return last, err
+		}
+		if status != nil {
+			last = status
+		}
+		if connectorRunning(last, wait.minRunningTasks) {
+			tflog.Debug(ctx, fmt.Sprintf("Connector %s running after %d attempts", connector.Metadata.Name, attempt))
+			return last, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Connector %s not running yet (attempt %d), sleep %s", connector.Metadata.Name, attempt, wait.pollInterval))
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("connector %s is not running after %s: %s", connector.Metadata.Name, time.Since(start).Round(time.Second), describeConnectorStatus(last))
+		case <-time.After(wait.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorStateAction(t *testing.T) {
+	tests := []struct {
+		current  string
+		desired  string
+		expected string
+	}{
+		{"RUNNING", "RUNNING", ""},
+		{"RUNNING", "PAUSED", "pause"},
+		{"RUNNING", "STOPPED", "stop"},
+		{"PAUSED", "RUNNING", "resume"},
+		{"STOPPED", "RUNNING", "resume"},
+		{"STOPPED", "PAUSED", "pause"},
+		{"PAUSED", "STOPPED", "stop"},
+		{"FAILED", "RUNNING", ""},
+		{"UNASSIGNED", "RUNNING", ""},
+		{"FAILED", "PAUSED", "pause"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+" to "+tt.desired, func(t *testing.T) {
+			assert.Equal(t, tt.expected, connectorStateAction(tt.current, tt.desired))
+		})
+	}
+}
+
+func TestRestartConnector(t *testing.T) {
+	var posts []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts = append(posts, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{})
+	}))
+	defer ts.Close()
+
+	apiClient, err := client.Make(context.Background(), client.CONSOLE, client.ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key"}, "test")
+	require.NoError(t, err)
+
+	connector := console.NewConnectorConsoleResource(
+		console.ConnectorConsoleMetadata{Name: "my-connector", Cluster: "my-cluster", ConnectCluster: "my-connect"},
+		console.ConnectorConsoleSpec{},
+	)
+	status := console.ConnectorStatus{
+		ConnectorState: "RUNNING",
+		Tasks: []console.ConnectorTaskStatus{
+			{Id: 0, State: "RUNNING"},
+			{Id: 1, State: "FAILED"},
+			{Id: 2, State: "FAILED"},
+		},
+	}
+
+	err = restartConnector(context.Background(), apiClient, &connector, &status)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/api/public/kafka/v2/cluster/my-cluster/connect/my-connect/connector/my-connector/restart",
+		"/api/public/kafka/v2/cluster/my-cluster/connect/my-connect/connector/my-connector/task/1/restart",
+		"/api/public/kafka/v2/cluster/my-cluster/connect/my-connect/connector/my-connector/task/2/restart",
+	}, posts)
+}
+
+func TestConnectorRunning(t *testing.T) {
+	one, three := int64(1), int64(3)
+	running := console.ConnectorStatus{ConnectorState: "RUNNING", Tasks: []console.ConnectorTaskStatus{{Id: 0, State: "RUNNING"}, {Id: 1, State: "RUNNING"}}}
+	failedTask := console.ConnectorStatus{ConnectorState: "RUNNING", Tasks: []console.ConnectorTaskStatus{{Id: 0, State: "RUNNING"}, {Id: 1, State: "FAILED"}}}
+
+	assert.True(t, connectorRunning(&running, nil))
+	assert.True(t, connectorRunning(&running, &one))
+	assert.False(t, connectorRunning(&running, &three))
+	assert.False(t, connectorRunning(&failedTask, nil))
+	assert.True(t, connectorRunning(&failedTask, &one))
+	assert.False(t, connectorRunning(&console.ConnectorStatus{ConnectorState: "RUNNING"}, nil))
+	assert.False(t, connectorRunning(&console.ConnectorStatus{ConnectorState: "FAILED", Tasks: running.Tasks}, nil))
+	assert.False(t, connectorRunning(nil, nil))
+}
+
+func newConnectorStatusServer(t *testing.T, statuses ...console.ConnectorStatus) (*client.Client, *atomic.Int32) {
+	t.Helper()
+	var reads atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(reads.Add(1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(statuses[i])
+	}))
+	t.Cleanup(ts.Close)
+
+	apiClient, err := client.Make(context.Background(), client.CONSOLE, client.ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key"}, "test")
+	require.NoError(t, err)
+	return apiClient, &reads
+}
+
+func TestWaitForConnectorRunning(t *testing.T) {
+	connector := console.NewConnectorConsoleResource(
+		console.ConnectorConsoleMetadata{Name: "my-connector", Cluster: "my-cluster", ConnectCluster: "my-connect"},
+		console.ConnectorConsoleSpec{},
+	)
+	wait := &connectorWait{timeout: 5 * time.Second, pollInterval: time.Millisecond}
+
+	apiClient, reads := newConnectorStatusServer(t,
+		console.ConnectorStatus{ConnectorState: "UNASSIGNED"},
+		console.ConnectorStatus{ConnectorState: "RUNNING", Tasks: []console.ConnectorTaskStatus{{Id: 0, State: "UNASSIGNED"}}},
+		console.ConnectorStatus{ConnectorState: "RUNNING", Tasks: []console.ConnectorTaskStatus{{Id: 0, State: "RUNNING"}}},
+	)
+	status, err := waitForConnectorRunning(context.Background(), apiClient, &connector, wait)
+	require.NoError(t, err)
+	assert.Equal(t, "RUNNING", status.Tasks[0].State)
+	assert.Equal(t, int32(3), reads.Load())
+
+	apiClient, _ = newConnectorStatusServer(t,
+		console.ConnectorStatus{ConnectorState: "RUNNING", Tasks: []console.ConnectorTaskStatus{{Id: 0, State: "FAILED", Trace: "org.apache.kafka.connect.errors.ConnectException: connection refused"}}},
+	)
+	wait.timeout = 50 * time.Millisecond
+	status, err = waitForConnectorRunning(context.Background(), apiClient, &connector, wait)
+	assert.ErrorContains(t, err, "connector my-connector is not running after")
+	assert.ErrorContains(t, err, "task 0 is FAILED\norg.apache.kafka.connect.errors.ConnectException: connection refused")
+	assert.Equal(t, "FAILED", status.Tasks[0].State)
+}
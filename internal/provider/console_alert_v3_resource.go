@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_alert_v3"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_alert_v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/mod/semver"
+)
+
+const alertV3MininumVersion = "v1.30.0"
+
+// alertV3Paths returns the apply path of an alert and its own path, both scoped to the alert owner by the parent
+// query params of the kind, like for generic resources.
+func alertV3Paths(alert *console.AlertConsoleResource) (string, string, error) {
+	kind, _, err := getKindFromName(console.AlertV3Kind)
+	if err != nil {
+		return "", "", err
+	}
+	document, err := alert.ToClientResource()
+	if err != nil {
+		return "", "", err
+	}
+	applyInfo, err := kind.ApplyPath(&document)
+	if err != nil {
+		return "", "", err
+	}
+	alertPath, err := documentPath(document)
+	if err != nil {
+		return "", "", err
+	}
+	return appendQueryParams(applyInfo.Path, applyInfo.QueryParams), alertPath, nil
+}
+
+// alertV3OwnerAttributes are the attributes of the alert owner, only one of them being set.
+var alertV3OwnerAttributes = []string{"app_instance", "group", "user"}
+
+// alertV3TypeAttributes are the spec attributes identifying the monitored resource, by alert type.
+var alertV3TypeAttributes = map[string][]string{
+	"BrokerAlert":        {},
+	"ConsumerGroupAlert": {"consumer_group_name"},
+	"KafkaConnectAlert":  {"connect_name", "connector_name"},
+	"TopicAlert":         {"topic_name"},
+}
+
+// alertV3DestinationAttributes are the destination attributes by destination type, required ones first.
+var alertV3DestinationAttributes = map[string]struct{ required, optional []string }{
+	"Email":   {required: []string{"emails"}},
+	"Slack":   {required: []string{"channel"}},
+	"Teams":   {required: []string{"url"}},
+	"Webhook": {required: []string{"url"}, optional: []string{"method", "headers", "body"}},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AlertV3Resource{}
+var _ resource.ResourceWithImportState = &AlertV3Resource{}
+var _ resource.ResourceWithModifyPlan = &AlertV3Resource{}
+var _ resource.ResourceWithValidateConfig = &AlertV3Resource{}
+
+func NewAlertV3Resource() resource.Resource {
+	return &AlertV3Resource{}
+}
+
+// AlertV3Resource defines the resource implementation.
+type AlertV3Resource struct {
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// alertV3ResourceModel is the generated model along with the operation timeouts.
+type alertV3ResourceModel struct {
+	schema.ConsoleAlertV3Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AlertV3Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_alert_v3"
+}
+
+func (r *AlertV3Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, schema.ConsoleAlertV3ResourceSchema(ctx))
+}
+
+func (r *AlertV3Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
+		return
+	}
+	if semver.IsValid(consoleVersion) && semver.Compare(consoleVersion, alertV3MininumVersion) < 0 {
+		resp.Diagnostics.AddError(
+			"Minimum version requirement not met",
+			"This resource requires Conduktor Console API version "+alertV3MininumVersion+" but targeted Conduktor Console API is "+consoleVersion,
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *AlertV3Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data alertV3ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAlertV3Spec(ctx, data.Spec)...)
+}
+
+func (r *AlertV3Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "alert",
+		func(ctx context.Context, data *alertV3ResourceModel) (console.AlertConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleAlertV3Model)
+		},
+		func(res console.AlertConsoleResource) (string, error) {
+			applyPath, _, err := alertV3Paths(&res)
+			return applyPath, err
+		})
+}
+
+func (r *AlertV3Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data alertV3ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Creating alert named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Create alert with desired state : %+v", data))
+
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleAlertV3Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create alert, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Alert to create : %+v", consoleResource))
+
+	applyPath, _, err := alertV3Paths(&consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to resolve alert path, got error: %s", err))
+		return
+	}
+
+	apply, err := r.apiClient.Apply(ctx, applyPath, consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Alert created with result: %s", apply.UpsertResult))
+
+	var consoleRes = console.AlertConsoleResource{}
+	err = consoleRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as alert : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New alert state : %+v", consoleRes))
+
+	data.ConsoleAlertV3Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertV3Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data alertV3ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Read alert named %s", data.Name.String()))
+	_, alertPath, err := alertV3Paths(alertV3Owned(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to resolve alert path, got error: %s", err))
+		return
+	}
+
+	get, err := r.apiClient.Describe(ctx, alertPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Alert %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var consoleRes = console.AlertConsoleResource{}
+	err = jsoniter.Unmarshal(get, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New alert state : %+v", consoleRes))
+
+	data.ConsoleAlertV3Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertV3Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data alertV3ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Updating alert named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update alert with TF data: %+v", data))
+
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleAlertV3Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to update alert, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Alert to update : %+v", consoleResource))
+
+	applyPath, _, err := alertV3Paths(&consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to resolve alert path, got error: %s", err))
+		return
+	}
+
+	apply, err := r.apiClient.Apply(ctx, applyPath, consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Alert updated with result: %s", apply))
+
+	var consoleRes = console.AlertConsoleResource{}
+	err = consoleRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as alert : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New alert state : %+v", consoleRes))
+
+	data.ConsoleAlertV3Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertV3Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data alertV3ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting alert named %s", data.Name.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, alertPath, err := alertV3Paths(alertV3Owned(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to resolve alert path, got error: %s", err))
+		return
+	}
+
+	err = r.apiClient.Delete(ctx, client.CONSOLE, alertPath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Alert %s deleted", data.Name.String()))
+}
+
+// ImportState imports the state of the resource from the given ID.
+// The ID is expected to be in the format: <owner_type>/<owner>/<alert_name>, the owner type being one of app_instance, group or user.
+func (r *AlertV3Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 3)
+
+	if len(idParts) != 3 || !slices.Contains(alertV3OwnerAttributes, idParts[0]) || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <owner_type>/<owner>/<alert_name>, owner type being one of %s. Got: %q", strings.Join(alertV3OwnerAttributes, ", "), req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idParts[0]), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+}
+
+// alertV3Owned returns an alert with the name and owner of the resource, enough to resolve its path.
+func alertV3Owned(data *alertV3ResourceModel) *console.AlertConsoleResource {
+	alert := console.NewAlertConsoleResource(
+		console.AlertConsoleMetadata{
+			Name:        data.Name.ValueString(),
+			AppInstance: data.AppInstance.ValueString(),
+			Group:       data.Group.ValueString(),
+			User:        data.User.ValueString(),
+		},
+		console.AlertConsoleSpec{},
+	)
+	return &alert
+}
+
+// validateAlertV3Spec checks the spec attributes identifying the monitored resource match the alert type, and the
+// destination attributes match the destination type. Values not known yet are not checked.
+func validateAlertV3Spec(ctx context.Context, spec schema.SpecValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !schemaUtils.AttrIsSet(spec) {
+		return diags
+	}
+
+	specObject, d := spec.ToObjectValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	specAttributes := specObject.Attributes()
+
+	if alertType := spec.SpecType; schemaUtils.AttrIsSet(alertType) {
+		var all []string
+		for _, attributes := range alertV3TypeAttributes {
+			all = append(all, attributes...)
+		}
+		checkAlertV3Attributes(&diags, path.Root("spec"), specAttributes, all, alertV3TypeAttributes[alertType.ValueString()], nil,
+			alertType.ValueString()+" alerts")
+	}
+
+	destination, ok := specAttributes["destination"].(basetypes.ObjectValue)
+	if !ok || !schemaUtils.AttrIsSet(destination) {
+		return diags
+	}
+	destinationAttributes := destination.Attributes()
+	destinationType, ok := destinationAttributes["type"].(basetypes.StringValue)
+	if !ok || !schemaUtils.AttrIsSet(destinationType) {
+		return diags
+	}
+	var all []string
+	for _, attributes := range alertV3DestinationAttributes {
+		all = append(all, attributes.required...)
+		all = append(all, attributes.optional...)
+	}
+	attributes := alertV3DestinationAttributes[destinationType.ValueString()]
+	checkAlertV3Attributes(&diags, path.Root("spec").AtName("destination"), destinationAttributes, all, attributes.required, attributes.optional,
+		destinationType.ValueString()+" destinations")
+	return diags
+}
+
+// checkAlertV3Attributes reports the required attributes not set, and the attributes set while not being required
+// nor optional, among all the attributes depending on a type.
+func checkAlertV3Attributes(diags *diag.Diagnostics, parent path.Path, values map[string]attr.Value, all, required, optional []string, typeDescription string) {
+	slices.Sort(all)
+	for _, name := range slices.Compact(all) {
+		value, ok := values[name]
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		switch {
+		case slices.Contains(required, name) && value.IsNull():
+			diags.AddAttributeError(parent.AtName(name), "Missing Attribute Configuration",
+				fmt.Sprintf("%s is required for %s", name, typeDescription))
+		case !slices.Contains(required, name) && !slices.Contains(optional, name) && !value.IsNull():
+			diags.AddAttributeError(parent.AtName(name), "Invalid Attribute Combination",
+				fmt.Sprintf("%s can't be set for %s", name, typeDescription))
+		}
+	}
+}
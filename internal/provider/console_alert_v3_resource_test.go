@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_alert_v3"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertV3Paths(t *testing.T) {
+	alert := console.NewAlertConsoleResource(
+		console.AlertConsoleMetadata{Name: "orders-lag", Group: "support-team"},
+		console.AlertConsoleSpec{},
+	)
+
+	applyPath, alertPath, err := alertV3Paths(&alert)
+	require.NoError(t, err)
+	assert.Equal(t, "/public/monitoring/v3/alert?group=support-team", applyPath)
+	assert.Equal(t, "/public/monitoring/v3/alert/orders-lag?group=support-team", alertPath)
+}
+
+func TestValidateAlertV3Spec(t *testing.T) {
+	ctx := context.Background()
+	newSpec := func(alertType string, spec map[string]attr.Value, destination map[string]attr.Value) schema.SpecValue {
+		destinationValues := map[string]attr.Value{
+			"type":    types.StringNull(),
+			"channel": types.StringNull(),
+			"url":     types.StringNull(),
+			"emails":  types.SetNull(types.StringType),
+			"method":  types.StringNull(),
+			"headers": types.MapNull(types.StringType),
+			"body":    types.StringNull(),
+		}
+		for k, v := range destination {
+			destinationValues[k] = v
+		}
+		destinationValue, diags := types.ObjectValue(schema.DestinationValue{}.AttributeTypes(ctx), destinationValues)
+		require.False(t, diags.HasError(), diags)
+
+		specValues := map[string]attr.Value{
+			"cluster":             types.StringValue("kafka-cluster"),
+			"type":                types.StringValue(alertType),
+			"topic_name":          types.StringNull(),
+			"consumer_group_name": types.StringNull(),
+			"connect_name":        types.StringNull(),
+			"connector_name":      types.StringNull(),
+			"metric":              types.StringValue("OffsetLag"),
+			"operator":            types.StringValue("GreaterThan"),
+			"threshold":           types.Int64Value(1000),
+			"description":         types.StringNull(),
+			"disable":             types.BoolValue(false),
+			"destination":         destinationValue,
+			"prom_ql":             types.StringUnknown(),
+		}
+		for k, v := range spec {
+			specValues[k] = v
+		}
+		specTypes := schema.SpecValue{}.AttributeTypes(ctx)
+		specTypes["destination"] = destinationValue.Type(ctx)
+		specValue, diags := schema.NewSpecValue(specTypes, specValues)
+		require.False(t, diags.HasError(), diags)
+		return specValue
+	}
+	slack := map[string]attr.Value{"type": types.StringValue("Slack"), "channel": types.StringValue("alerts")}
+
+	tests := []struct {
+		name   string
+		spec   schema.SpecValue
+		errors []path.Path
+	}{
+		{
+			name: "valid consumer group alert",
+			spec: newSpec("ConsumerGroupAlert", map[string]attr.Value{"consumer_group_name": types.StringValue("orders")}, slack),
+		},
+		{
+			name: "valid webhook destination",
+			spec: newSpec("BrokerAlert", nil, map[string]attr.Value{
+				"type":    types.StringValue("Webhook"),
+				"url":     types.StringValue("https://example.com/hook"),
+				"headers": types.MapValueMust(types.StringType, map[string]attr.Value{"X-Source": types.StringValue("conduktor")}),
+			}),
+		},
+		{
+			name: "unknown values are not checked",
+			spec: newSpec("TopicAlert", map[string]attr.Value{"topic_name": types.StringUnknown()}, map[string]attr.Value{
+				"type":   types.StringUnknown(),
+				"url":    types.StringValue("https://example.com/hook"),
+				"emails": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ops@example.com")}),
+			}),
+		},
+		{
+			name: "missing and extra monitored resource",
+			spec: newSpec("KafkaConnectAlert", map[string]attr.Value{
+				"connect_name": types.StringValue("connect"),
+				"topic_name":   types.StringValue("orders"),
+			}, slack),
+			errors: []path.Path{
+				path.Root("spec").AtName("connector_name"),
+				path.Root("spec").AtName("topic_name"),
+			},
+		},
+		{
+			name: "missing and extra destination attributes",
+			spec: newSpec("BrokerAlert", nil, map[string]attr.Value{
+				"type":    types.StringValue("Teams"),
+				"channel": types.StringValue("alerts"),
+				"body":    types.StringValue("{}"),
+			}),
+			errors: []path.Path{
+				path.Root("spec").AtName("destination").AtName("body"),
+				path.Root("spec").AtName("destination").AtName("channel"),
+				path.Root("spec").AtName("destination").AtName("url"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateAlertV3Spec(ctx, tt.spec)
+			var errors []path.Path
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.(diag.DiagnosticWithPath).Path())
+			}
+			assert.ElementsMatch(t, tt.errors, errors)
+		})
+	}
+
+	assert.Empty(t, validateAlertV3Spec(ctx, schema.NewSpecValueUnknown()))
+}
+
+func TestAccAlertV3Resource(t *testing.T) {
+	v, err := fetchClientVersion(client.CONSOLE)
+	if err != nil {
+		t.Fatalf("Error fetching current version: %s", err)
+	}
+	test.CheckMinimumVersionRequirement(t, v, alertV3MininumVersion)
+	resourceRef := "conduktor_console_alert_v3.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid type specific attributes are rejected before reaching Console
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/alert_v3/resource_not_valid.tf"),
+				ExpectError: regexp.MustCompile("(consumer_group_name is required|url is required)"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/alert_v3/resource_create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-alert"),
+					resource.TestCheckResourceAttr(resourceRef, "group", "alert-team"),
+					resource.TestCheckNoResourceAttr(resourceRef, "app_instance"),
+					resource.TestCheckNoResourceAttr(resourceRef, "user"),
+					resource.TestCheckResourceAttrSet(resourceRef, "updated_at"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.cluster", "kafka-cluster"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.type", "TopicAlert"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.topic_name", "orders"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.metric", "MessageCount"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.threshold", "1000"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.disable", "false"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.type", "Slack"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.channel", "alerts"),
+					resource.TestCheckResourceAttrSet(resourceRef, "spec.prom_ql"),
+				),
+			},
+			// Importing matches the state of the previous step.
+			{
+				ResourceName:                         resourceRef,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "group/alert-team/test-alert",
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"updated_at"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/alert_v3/resource_update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-alert"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.threshold", "10"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.description", "Orders topic is idle"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.disable", "true"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.type", "Webhook"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.url", "https://example.com/hook"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.method", "POST"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.destination.headers.X-Source", "conduktor"),
+					resource.TestCheckNoResourceAttr(resourceRef, "spec.destination.channel"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAlertV3ExampleResource(t *testing.T) {
+	v, err := fetchClientVersion(client.CONSOLE)
+	if err != nil {
+		t.Fatalf("Error fetching current version: %s", err)
+	}
+	test.CheckMinimumVersionRequirement(t, v, alertV3MininumVersion)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read from simple example
+			{
+				Config: providerConfigConsole + test.TestAccExample(t, "resources", "conduktor_console_alert_v3", "simple.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.simple", "name", "orders-volume"),
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.simple", "spec.type", "TopicAlert"),
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.simple", "spec.destination.type", "Slack"),
+				),
+			},
+			// Create and Read from complex example
+			{
+				Config: providerConfigConsole + test.TestAccExample(t, "resources", "conduktor_console_alert_v3", "complex.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.complex", "name", "orders-consumer-lag"),
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.complex", "spec.type", "ConsumerGroupAlert"),
+					resource.TestCheckResourceAttr("conduktor_console_alert_v3.complex", "spec.destination.type", "Webhook"),
+				),
+			},
+		},
+	})
+}
@@ -8,6 +8,8 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_application_group_v1"
 	"github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_application_group_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,6 +23,7 @@ const applicationGroupV1InstancePermissionsMinVersion = "v1.44.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationGroupV1Resource{}
 var _ resource.ResourceWithImportState = &ApplicationGroupV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationGroupV1Resource{}
 
 func NewApplicationGroupV1Resource() resource.Resource {
 	return &ApplicationGroupV1Resource{}
@@ -28,7 +31,15 @@ func NewApplicationGroupV1Resource() resource.Resource {
 
 // ApplicationGroupV1Resource defines the resource implementation.
 type ApplicationGroupV1Resource struct {
-	apiClient *client.Client
+	apiClient                *client.Client
+	validateOnPlan           bool
+	evaluateResourcePolicies bool
+}
+
+// applicationGroupV1ResourceModel is the generated model along with the operation timeouts.
+type applicationGroupV1ResourceModel struct {
+	schema.ConsoleApplicationGroupV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ApplicationGroupV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -36,7 +47,7 @@ func (r *ApplicationGroupV1Resource) Metadata(ctx context.Context, req resource.
 }
 
 func (r *ApplicationGroupV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleApplicationGroupV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleApplicationGroupV1ResourceSchema(ctx))
 }
 
 func (r *ApplicationGroupV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,32 +67,50 @@ func (r *ApplicationGroupV1Resource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, applicationGroupV1EnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, applicationGroupV1EnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+	r.evaluateResourcePolicies = data.EvaluateResourcePolicies
+}
+
+func (r *ApplicationGroupV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var checks []func(console.ApplicationGroupConsoleResource) diag.Diagnostics
+	if r.evaluateResourcePolicies {
+		checks = append(checks, func(res console.ApplicationGroupConsoleResource) diag.Diagnostics {
+			return evaluateResourcePolicies(ctx, r.apiClient, res, instancesOf(res.Metadata.Application))
+		})
+	}
+
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "application group",
+		func(ctx context.Context, data *applicationGroupV1ResourceModel) (console.ApplicationGroupConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleApplicationGroupV1Model)
+		},
+		staticPutPath[console.ApplicationGroupConsoleResource](applicationGroupV1ApiPath), checks...)
 }
 
 func (r *ApplicationGroupV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleApplicationGroupV1Model
+	var data applicationGroupV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -90,10 +119,18 @@ func (r *ApplicationGroupV1Resource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating application group named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create application group with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationGroupV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application group, got error: %s", err))
 		return
@@ -116,7 +153,7 @@ func (r *ApplicationGroupV1Resource) Create(ctx context.Context, req resource.Cr
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationGroupV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application group, got error: %s", err))
 		return
@@ -127,7 +164,7 @@ func (r *ApplicationGroupV1Resource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *ApplicationGroupV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleApplicationGroupV1Model
+	var data applicationGroupV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -136,6 +173,14 @@ func (r *ApplicationGroupV1Resource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read application group named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", applicationGroupV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -157,7 +202,7 @@ func (r *ApplicationGroupV1Resource) Read(ctx context.Context, req resource.Read
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationGroupV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application group, got error: %s", err))
 		return
@@ -168,7 +213,7 @@ func (r *ApplicationGroupV1Resource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *ApplicationGroupV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleApplicationGroupV1Model
+	var data applicationGroupV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -177,10 +222,18 @@ func (r *ApplicationGroupV1Resource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating application group named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update application group with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationGroupV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application group, got error: %s", err))
 		return
@@ -202,7 +255,7 @@ func (r *ApplicationGroupV1Resource) Update(ctx context.Context, req resource.Up
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationGroupV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application group, got error: %s", err))
 		return
@@ -212,7 +265,7 @@ func (r *ApplicationGroupV1Resource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *ApplicationGroupV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleApplicationGroupV1Model
+	var data applicationGroupV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -223,6 +276,14 @@ func (r *ApplicationGroupV1Resource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", applicationGroupV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_application_instance_permission_v1"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_application_instance_permission_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +23,7 @@ const applicationInstancePermissionEnterpriseOnlyVersion = "v1.43.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationInstancePermissionV1Resource{}
 var _ resource.ResourceWithImportState = &ApplicationInstancePermissionV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationInstancePermissionV1Resource{}
 
 func NewApplicationInstancePermissionV1Resource() resource.Resource {
 	return &ApplicationInstancePermissionV1Resource{}
@@ -29,7 +31,14 @@ func NewApplicationInstancePermissionV1Resource() resource.Resource {
 
 // ApplicationInstancePermissionV1Resource defines the resource implementation.
 type ApplicationInstancePermissionV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// applicationInstancePermissionV1ResourceModel is the generated model along with the operation timeouts.
+type applicationInstancePermissionV1ResourceModel struct {
+	schema.ConsoleApplicationInstancePermissionV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +46,7 @@ func (r *ApplicationInstancePermissionV1Resource) Metadata(ctx context.Context,
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleApplicationInstancePermissionV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleApplicationInstancePermissionV1ResourceSchema(ctx))
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,17 +66,18 @@ func (r *ApplicationInstancePermissionV1Resource) Configure(ctx context.Context,
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Console version",
@@ -83,16 +93,25 @@ func (r *ApplicationInstancePermissionV1Resource) Configure(ctx context.Context,
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, applicationInstancePermissionEnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, applicationInstancePermissionEnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *ApplicationInstancePermissionV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "application instance permission",
+		func(ctx context.Context, data *applicationInstancePermissionV1ResourceModel) (console.ApplicationInstancePermissionConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstancePermissionV1Model)
+		},
+		staticPutPath[console.ApplicationInstancePermissionConsoleResource](applicationInstancePermissionV1ApiPath))
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleApplicationInstancePermissionV1Model
+	var data applicationInstancePermissionV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -101,10 +120,18 @@ func (r *ApplicationInstancePermissionV1Resource) Create(ctx context.Context, re
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating application instance permission named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create application instance permission with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstancePermissionV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application instance permission, got error: %s", err))
 		return
@@ -127,7 +154,7 @@ func (r *ApplicationInstancePermissionV1Resource) Create(ctx context.Context, re
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance permission state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstancePermissionV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance permission, got error: %s", err))
 		return
@@ -138,7 +165,7 @@ func (r *ApplicationInstancePermissionV1Resource) Create(ctx context.Context, re
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleApplicationInstancePermissionV1Model
+	var data applicationInstancePermissionV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -147,6 +174,14 @@ func (r *ApplicationInstancePermissionV1Resource) Read(ctx context.Context, req
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read application instance permission named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", applicationInstancePermissionV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -168,7 +203,7 @@ func (r *ApplicationInstancePermissionV1Resource) Read(ctx context.Context, req
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance permission state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstancePermissionV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance permission, got error: %s", err))
 		return
@@ -179,7 +214,7 @@ func (r *ApplicationInstancePermissionV1Resource) Read(ctx context.Context, req
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleApplicationInstancePermissionV1Model
+	var data applicationInstancePermissionV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -188,10 +223,18 @@ func (r *ApplicationInstancePermissionV1Resource) Update(ctx context.Context, re
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating application instance permission named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update application instance permission with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstancePermissionV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application instance permission, got error: %s", err))
 		return
@@ -213,7 +256,7 @@ func (r *ApplicationInstancePermissionV1Resource) Update(ctx context.Context, re
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance permission state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstancePermissionV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance permission, got error: %s", err))
 		return
@@ -223,7 +266,7 @@ func (r *ApplicationInstancePermissionV1Resource) Update(ctx context.Context, re
 }
 
 func (r *ApplicationInstancePermissionV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleApplicationInstancePermissionV1Model
+	var data applicationInstancePermissionV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -234,6 +277,14 @@ func (r *ApplicationInstancePermissionV1Resource) Delete(ctx context.Context, re
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", applicationInstancePermissionV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
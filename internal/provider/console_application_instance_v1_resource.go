@@ -8,6 +8,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_application_instance_v1"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_application_instance_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +23,7 @@ const appInstanceEnterpriseOnlyVersion = "v1.43.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationInstanceV1Resource{}
 var _ resource.ResourceWithImportState = &ApplicationInstanceV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationInstanceV1Resource{}
 
 func NewApplicationInstanceV1Resource() resource.Resource {
 	return &ApplicationInstanceV1Resource{}
@@ -29,7 +31,14 @@ func NewApplicationInstanceV1Resource() resource.Resource {
 
 // ApplicationInstanceV1Resource defines the resource implementation.
 type ApplicationInstanceV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// applicationInstanceV1ResourceModel is the generated model along with the operation timeouts.
+type applicationInstanceV1ResourceModel struct {
+	schema.ConsoleApplicationInstanceV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ApplicationInstanceV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +46,7 @@ func (r *ApplicationInstanceV1Resource) Metadata(ctx context.Context, req resour
 }
 
 func (r *ApplicationInstanceV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleApplicationInstanceV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleApplicationInstanceV1ResourceSchema(ctx))
 }
 
 func (r *ApplicationInstanceV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,17 +66,18 @@ func (r *ApplicationInstanceV1Resource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
@@ -80,16 +90,25 @@ func (r *ApplicationInstanceV1Resource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, appInstanceEnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, appInstanceEnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *ApplicationInstanceV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "application instance",
+		func(ctx context.Context, data *applicationInstanceV1ResourceModel) (console.ApplicationInstanceConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstanceV1Model)
+		},
+		staticPutPath[console.ApplicationInstanceConsoleResource](applicationInstanceV1ApiPath))
 }
 
 func (r *ApplicationInstanceV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleApplicationInstanceV1Model
+	var data applicationInstanceV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -98,10 +117,18 @@ func (r *ApplicationInstanceV1Resource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating application instance named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create application instance with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstanceV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application instance, got error: %s", err))
 		return
@@ -124,7 +151,7 @@ func (r *ApplicationInstanceV1Resource) Create(ctx context.Context, req resource
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstanceV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance, got error: %s", err))
 		return
@@ -135,7 +162,7 @@ func (r *ApplicationInstanceV1Resource) Create(ctx context.Context, req resource
 }
 
 func (r *ApplicationInstanceV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleApplicationInstanceV1Model
+	var data applicationInstanceV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -144,6 +171,14 @@ func (r *ApplicationInstanceV1Resource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read application instance named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", applicationInstanceV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -165,7 +200,7 @@ func (r *ApplicationInstanceV1Resource) Read(ctx context.Context, req resource.R
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstanceV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance, got error: %s", err))
 		return
@@ -176,7 +211,7 @@ func (r *ApplicationInstanceV1Resource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ApplicationInstanceV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleApplicationInstanceV1Model
+	var data applicationInstanceV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -185,10 +220,18 @@ func (r *ApplicationInstanceV1Resource) Update(ctx context.Context, req resource
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating application instance named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update application instance with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationInstanceV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application instance, got error: %s", err))
 		return
@@ -210,7 +253,7 @@ func (r *ApplicationInstanceV1Resource) Update(ctx context.Context, req resource
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application instance state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationInstanceV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application instance, got error: %s", err))
 		return
@@ -220,7 +263,7 @@ func (r *ApplicationInstanceV1Resource) Update(ctx context.Context, req resource
 }
 
 func (r *ApplicationInstanceV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleApplicationInstanceV1Model
+	var data applicationInstanceV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -231,6 +274,14 @@ func (r *ApplicationInstanceV1Resource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", applicationInstanceV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
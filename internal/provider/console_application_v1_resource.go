@@ -8,6 +8,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_application_v1"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_application_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +21,7 @@ const applicationV1EnterpriseOnlyVersion = "v1.43.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationV1Resource{}
 var _ resource.ResourceWithImportState = &ApplicationV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationV1Resource{}
 
 func NewApplicationV1Resource() resource.Resource {
 	return &ApplicationV1Resource{}
@@ -27,7 +29,14 @@ func NewApplicationV1Resource() resource.Resource {
 
 // ApplicationV1Resource defines the resource implementation.
 type ApplicationV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// applicationV1ResourceModel is the generated model along with the operation timeouts.
+type applicationV1ResourceModel struct {
+	schema.ConsoleApplicationV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ApplicationV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +44,7 @@ func (r *ApplicationV1Resource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *ApplicationV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleApplicationV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleApplicationV1ResourceSchema(ctx))
 }
 
 func (r *ApplicationV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,32 +64,42 @@ func (r *ApplicationV1Resource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, applicationV1EnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, applicationV1EnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *ApplicationV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "application",
+		func(ctx context.Context, data *applicationV1ResourceModel) (console.ApplicationConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleApplicationV1Model)
+		},
+		staticPutPath[console.ApplicationConsoleResource](applicationV1ApiPath))
 }
 
 func (r *ApplicationV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleApplicationV1Model
+	var data applicationV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,10 +108,18 @@ func (r *ApplicationV1Resource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating application named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create application with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application, got error: %s", err))
 		return
@@ -115,7 +142,7 @@ func (r *ApplicationV1Resource) Create(ctx context.Context, req resource.CreateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
@@ -126,7 +153,7 @@ func (r *ApplicationV1Resource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *ApplicationV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleApplicationV1Model
+	var data applicationV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -135,6 +162,14 @@ func (r *ApplicationV1Resource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read application named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", applicationV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -156,7 +191,7 @@ func (r *ApplicationV1Resource) Read(ctx context.Context, req resource.ReadReque
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
@@ -167,7 +202,7 @@ func (r *ApplicationV1Resource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *ApplicationV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleApplicationV1Model
+	var data applicationV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -176,10 +211,18 @@ func (r *ApplicationV1Resource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating application named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update application with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleApplicationV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create application, got error: %s", err))
 		return
@@ -201,7 +244,7 @@ func (r *ApplicationV1Resource) Update(ctx context.Context, req resource.UpdateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New application state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleApplicationV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read application, got error: %s", err))
 		return
@@ -211,7 +254,7 @@ func (r *ApplicationV1Resource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *ApplicationV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleApplicationV1Model
+	var data applicationV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -222,6 +265,14 @@ func (r *ApplicationV1Resource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", applicationV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
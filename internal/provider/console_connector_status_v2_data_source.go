@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectorStatusV2DataSource{}
+var _ datasource.DataSourceWithConfigure = &ConnectorStatusV2DataSource{}
+
+func NewConnectorStatusV2DataSource() datasource.DataSource {
+	return &ConnectorStatusV2DataSource{}
+}
+
+// ConnectorStatusV2DataSource defines the data source implementation.
+type ConnectorStatusV2DataSource struct {
+	apiClient *client.Client
+}
+
+// ConnectorStatusV2DataSourceModel describes the data source data model.
+type ConnectorStatusV2DataSourceModel struct {
+	Cluster        types.String                 `tfsdk:"cluster"`
+	ConnectCluster types.String                 `tfsdk:"connect_cluster"`
+	Name           types.String                 `tfsdk:"name"`
+	State          types.String                 `tfsdk:"state"`
+	WorkerId       types.String                 `tfsdk:"worker_id"`
+	Trace          types.String                 `tfsdk:"trace"`
+	Tasks          []ConnectorTaskStatusV2Model `tfsdk:"tasks"`
+}
+
+// ConnectorTaskStatusV2Model describes the status of a connector task.
+type ConnectorTaskStatusV2Model struct {
+	Id       types.Int64  `tfsdk:"id"`
+	State    types.String `tfsdk:"state"`
+	WorkerId types.String `tfsdk:"worker_id"`
+	Trace    types.String `tfsdk:"trace"`
+}
+
+func (d *ConnectorStatusV2DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_connector_status_v2"
+}
+
+func (d *ConnectorStatusV2DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description:         "Read the runtime status of a Kafka Connect connector and its tasks through Conduktor Console.",
+		MarkdownDescription: "Read the runtime status of a Kafka Connect connector and its tasks through Conduktor Console.",
+		Attributes: map[string]dschema.Attribute{
+			"cluster": dschema.StringAttribute{
+				Required:            true,
+				Description:         "Kafka cluster name linked with the Kafka Connect server",
+				MarkdownDescription: "Kafka cluster name linked with the Kafka Connect server",
+			},
+			"connect_cluster": dschema.StringAttribute{
+				Required:            true,
+				Description:         "Kafka Connect server name running the connector",
+				MarkdownDescription: "Kafka Connect server name running the connector",
+			},
+			"name": dschema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the connector to read the status of",
+				MarkdownDescription: "Name of the connector to read the status of",
+			},
+			"state": dschema.StringAttribute{
+				Computed:            true,
+				Description:         "Connector state, like RUNNING, PAUSED, STOPPED, FAILED or UNASSIGNED",
+				MarkdownDescription: "Connector state, like `RUNNING`, `PAUSED`, `STOPPED`, `FAILED` or `UNASSIGNED`",
+			},
+			"worker_id": dschema.StringAttribute{
+				Computed:            true,
+				Description:         "Kafka Connect worker running the connector",
+				MarkdownDescription: "Kafka Connect worker running the connector",
+			},
+			"trace": dschema.StringAttribute{
+				Computed:            true,
+				Description:         "Stack trace of the connector failure, if any",
+				MarkdownDescription: "Stack trace of the connector failure, if any",
+			},
+			"tasks": dschema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Status of the connector tasks, sorted by id",
+				MarkdownDescription: "Status of the connector tasks, sorted by id",
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"id": dschema.Int64Attribute{
+							Computed:            true,
+							Description:         "Task id",
+							MarkdownDescription: "Task id",
+						},
+						"state": dschema.StringAttribute{
+							Computed:            true,
+							Description:         "Task state, like RUNNING, PAUSED or FAILED",
+							MarkdownDescription: "Task state, like `RUNNING`, `PAUSED` or `FAILED`",
+						},
+						"worker_id": dschema.StringAttribute{
+							Computed:            true,
+							Description:         "Kafka Connect worker running the task",
+							MarkdownDescription: "Kafka Connect worker running the task",
+						},
+						"trace": dschema.StringAttribute{
+							Computed:            true,
+							Description:         "Stack trace of the task failure, if any",
+							MarkdownDescription: "Stack trace of the task failure, if any",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectorStatusV2DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this data source. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	d.apiClient = apiClient
+}
+
+func (d *ConnectorStatusV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectorStatusV2DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read status of connector named %s", data.Name.String()))
+	status, err := readConnectorStatus(ctx, d.apiClient, data.Cluster.ValueString(), data.ConnectCluster.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connector status, got error: %s", err))
+		return
+	}
+
+	if status == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Connector not found",
+			fmt.Sprintf("No connector named %s found on Kafka Connect server %s of cluster %s", data.Name.String(), data.ConnectCluster.String(), data.Cluster.String()),
+		)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Connector status : %+v", status))
+
+	setConnectorStatus(&data, status)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setConnectorStatus sets the computed attributes of the data source model from the connector status.
+func setConnectorStatus(data *ConnectorStatusV2DataSourceModel, status *console.ConnectorStatus) {
+	data.State = types.StringValue(status.ConnectorState)
+	data.WorkerId = schemaUtils.NewStringValue(status.WorkerId)
+	data.Trace = schemaUtils.NewStringValue(status.Trace)
+
+	data.Tasks = make([]ConnectorTaskStatusV2Model, 0, len(status.Tasks))
+	for _, task := range status.Tasks {
+		data.Tasks = append(data.Tasks, ConnectorTaskStatusV2Model{
+			Id:       types.Int64Value(task.Id),
+			State:    types.StringValue(task.State),
+			WorkerId: schemaUtils.NewStringValue(task.WorkerId),
+			Trace:    schemaUtils.NewStringValue(task.Trace),
+		})
+	}
+	sort.Slice(data.Tasks, func(i, j int) bool {
+		return data.Tasks[i].Id.ValueInt64() < data.Tasks[j].Id.ValueInt64()
+	})
+}
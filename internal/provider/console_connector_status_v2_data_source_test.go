@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectorStatusV2DataSource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t) // skip when no license because Gateway will likely not be up
+	v, err := fetchClientVersion(client.CONSOLE)
+	if err != nil {
+		t.Fatalf("Error fetching current version: %s", err)
+	}
+	test.CheckMinimumVersionRequirement(t, v, connectorMininumVersion)
+
+	dataSourceRef := "data.conduktor_console_connector_status_v2.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/connector_status_v2/data_source.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceRef, "name", "connector-status-test"),
+					resource.TestCheckResourceAttr(dataSourceRef, "cluster", "kafka-cluster"),
+					resource.TestCheckResourceAttr(dataSourceRef, "connect_cluster", "kafka-connect"),
+					resource.TestCheckResourceAttrSet(dataSourceRef, "state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectorStatusV2DataSourceNotFound(t *testing.T) {
+	test.CheckEnterpriseEnabled(t) // skip when no license because Gateway will likely not be up
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/connector_status_v2/data_source_not_found.tf"),
+				ExpectError: regexp.MustCompile(`Connector not found`),
+			},
+		},
+	})
+}
+
+func TestSetConnectorStatus(t *testing.T) {
+	data := ConnectorStatusV2DataSourceModel{Name: types.StringValue("my-connector")}
+	setConnectorStatus(&data, &console.ConnectorStatus{
+		ConnectorState: "RUNNING",
+		WorkerId:       "connect:8083",
+		Tasks: []console.ConnectorTaskStatus{
+			{Id: 1, State: "FAILED", WorkerId: "connect:8083", Trace: "org.apache.kafka.connect.errors.ConnectException"},
+			{Id: 0, State: "RUNNING", WorkerId: "connect:8083"},
+		},
+	})
+
+	assert.Equal(t, types.StringValue("RUNNING"), data.State)
+	assert.Equal(t, types.StringValue("connect:8083"), data.WorkerId)
+	assert.True(t, data.Trace.IsNull())
+	assert.Equal(t, []ConnectorTaskStatusV2Model{
+		{Id: types.Int64Value(0), State: types.StringValue("RUNNING"), WorkerId: types.StringValue("connect:8083"), Trace: types.StringNull()},
+		{Id: types.Int64Value(1), State: types.StringValue("FAILED"), WorkerId: types.StringValue("connect:8083"), Trace: types.StringValue("org.apache.kafka.connect.errors.ConnectException")},
+	}, data.Tasks)
+}
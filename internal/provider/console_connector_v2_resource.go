@@ -9,8 +9,11 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_connector_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_connector_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	jsoniter "github.com/json-iterator/go"
 	"golang.org/x/mod/semver"
@@ -34,6 +37,7 @@ const connectorMininumRecommendedVersion = "v1.29.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ConnectorV2Resource{}
 var _ resource.ResourceWithImportState = &ConnectorV2Resource{}
+var _ resource.ResourceWithModifyPlan = &ConnectorV2Resource{}
 
 func NewConnectorV2Resource() resource.Resource {
 	return &ConnectorV2Resource{}
@@ -41,7 +45,15 @@ func NewConnectorV2Resource() resource.Resource {
 
 // ConnectorV2Resource defines the resource implementation.
 type ConnectorV2Resource struct {
-	apiClient *client.Client
+	apiClient                *client.Client
+	validateOnPlan           bool
+	evaluateResourcePolicies bool
+}
+
+// connectorV2ResourceModel is the generated model along with the operation timeouts.
+type connectorV2ResourceModel struct {
+	schema.ConsoleConnectorV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ConnectorV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,7 +61,7 @@ func (r *ConnectorV2Resource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *ConnectorV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleConnectorV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleConnectorV2ResourceSchema(ctx))
 }
 
 func (r *ConnectorV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -69,17 +81,18 @@ func (r *ConnectorV2Resource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
@@ -100,11 +113,30 @@ func (r *ConnectorV2Resource) Configure(ctx context.Context, req resource.Config
 		}
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+	r.evaluateResourcePolicies = data.EvaluateResourcePolicies
+}
+
+func (r *ConnectorV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var checks []func(console.ConnectorConsoleResource) diag.Diagnostics
+	if r.evaluateResourcePolicies {
+		checks = append(checks, func(res console.ConnectorConsoleResource) diag.Diagnostics {
+			return evaluateResourcePolicies(ctx, r.apiClient, res, ownedBy(res.Metadata.Cluster, "CONNECTOR", res.Metadata.Name))
+		})
+	}
+
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "connector",
+		func(ctx context.Context, data *connectorV2ResourceModel) (console.ConnectorConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleConnectorV2Model)
+		},
+		func(res console.ConnectorConsoleResource) (string, error) {
+			return connectorV2ApiPutPath(res.Metadata.Cluster, res.Metadata.ConnectCluster), nil
+		}, checks...)
 }
 
 func (r *ConnectorV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleConnectorV2Model
+	var data connectorV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -113,10 +145,18 @@ func (r *ConnectorV2Resource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating connector named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create connector with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleConnectorV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create connector, got error: %s", err))
 		return
@@ -140,18 +180,29 @@ func (r *ConnectorV2Resource) Create(ctx context.Context, req resource.CreateReq
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New connector state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	desiredState, restartTriggers, waitForRunning := data.State.ValueString(), data.RestartTriggers, data.WaitForRunning
+	data.ConsoleConnectorV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read connector, got error: %s", err))
 		return
 	}
 
+	err = r.reconcileLifecycle(ctx, &consoleRes, &data.ConsoleConnectorV2Model, desiredState, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set connector state to %s, got error: %s", desiredState, err))
+		return
+	}
+	data.RestartTriggers, data.WaitForRunning = restartTriggers, waitForRunning
+
+	// The connector is saved even if it doesn't run, to be tainted and replaced on next apply.
+	resp.Diagnostics.Append(r.waitForRunning(ctx, &consoleRes, &data.ConsoleConnectorV2Model)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConnectorV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleConnectorV2Model
+	var data connectorV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,6 +211,14 @@ func (r *ConnectorV2Resource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read connector named %s", data.Name.String()))
 	getPath := connectorV2ApiGetPath(data.Cluster.ValueString(), data.ConnectCluster.ValueString(), data.Name.ValueString())
 	get, err := r.apiClient.Describe(ctx, getPath)
@@ -182,30 +241,58 @@ func (r *ConnectorV2Resource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New connector state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	priorState, priorTasks, restartTriggers, waitForRunning := data.State, data.Tasks, data.RestartTriggers, data.WaitForRunning
+	data.ConsoleConnectorV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read connector, got error: %s", err))
 		return
 	}
+	data.State, data.RestartTriggers, data.WaitForRunning = priorState, restartTriggers, waitForRunning
+	if data.State.IsNull() {
+		// Imported connectors are expected to run unless Kafka Connect reports otherwise.
+		data.State = types.StringValue("RUNNING")
+	}
+
+	status, err := readConnectorStatus(ctx, r.apiClient, consoleRes.Metadata.Cluster, consoleRes.Metadata.ConnectCluster, consoleRes.Metadata.Name)
+	if err != nil {
+		// The connector itself was read, an unavailable Kafka Connect must not fail the refresh.
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to read connector status, keeping the previous tasks and state, got error: %s", err))
+		data.Tasks = priorTasks
+	} else if status != nil {
+		err = mapper.StatusToTerraform(ctx, status, &data.ConsoleConnectorV2Model)
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read connector status, got error: %s", err))
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConnectorV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleConnectorV2Model
+	var data, state connectorV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating connector named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update connector with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleConnectorV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create connector, got error: %s", err))
 		return
@@ -228,17 +315,29 @@ func (r *ConnectorV2Resource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New connector state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	desiredState, restartTriggers, waitForRunning := data.State.ValueString(), data.RestartTriggers, data.WaitForRunning
+	restart := !restartTriggers.IsNull() && !restartTriggers.Equal(state.RestartTriggers)
+	data.ConsoleConnectorV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read connector, got error: %s", err))
 		return
 	}
+
+	err = r.reconcileLifecycle(ctx, &consoleRes, &data.ConsoleConnectorV2Model, desiredState, restart)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set connector state to %s, got error: %s", desiredState, err))
+		return
+	}
+	data.RestartTriggers, data.WaitForRunning = restartTriggers, waitForRunning
+
+	resp.Diagnostics.Append(r.waitForRunning(ctx, &consoleRes, &data.ConsoleConnectorV2Model)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ConnectorV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleConnectorV2Model
+	var data connectorV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -249,6 +348,14 @@ func (r *ConnectorV2Resource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := connectorV2ApiGetPath(data.Cluster.ValueString(), data.ConnectCluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -259,6 +366,73 @@ func (r *ConnectorV2Resource) Delete(ctx context.Context, req resource.DeleteReq
 	tflog.Debug(ctx, fmt.Sprintf("Connector %s deleted", data.Name.String()))
 }
 
+// reconcileLifecycle pauses, resumes or stops the connector to reach the desired state, restarts it and its failed
+// tasks if requested, then sets the state and task statuses of the Terraform model.
+func (r *ConnectorV2Resource) reconcileLifecycle(ctx context.Context, connector *console.ConnectorConsoleResource, model *schema.ConsoleConnectorV2Model, desiredState string, restart bool) error {
+	status, err := readConnectorStatus(ctx, r.apiClient, connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name)
+	if err != nil {
+		return err
+	}
+
+	err = reconcileConnectorState(ctx, r.apiClient, connector, status, desiredState)
+	if err != nil {
+		return err
+	}
+
+	if restart {
+		if desiredState == "RUNNING" {
+			err = restartConnector(ctx, r.apiClient, connector, status)
+			if err != nil {
+				return err
+			}
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("Connector %s is %s, restart triggers ignored", connector.Metadata.Name, desiredState))
+		}
+	}
+
+	status, err = readConnectorStatus(ctx, r.apiClient, connector.Metadata.Cluster, connector.Metadata.ConnectCluster, connector.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	if status != nil {
+		err = mapper.StatusToTerraform(ctx, status, model)
+		if err != nil {
+			return err
+		}
+	}
+	// Kafka Connect applies state changes asynchronously, the desired state is kept whatever the status reports.
+	model.State = types.StringValue(desiredState)
+	return nil
+}
+
+// waitForRunning waits for the connector and its tasks to be running if wait_for_running is set and the desired
+// state is RUNNING, then sets the task statuses of the Terraform model from the last status read.
+func (r *ConnectorV2Resource) waitForRunning(ctx context.Context, connector *console.ConnectorConsoleResource, model *schema.ConsoleConnectorV2Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wait, err := connectorWaitFromModel(model.WaitForRunning)
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_running"), "Invalid Configuration", err.Error())
+		return diags
+	}
+	if wait == nil || model.State.ValueString() != "RUNNING" {
+		return diags
+	}
+
+	status, err := waitForConnectorRunning(ctx, r.apiClient, connector, wait)
+	if status != nil {
+		desiredState := model.State
+		if mapErr := mapper.StatusToTerraform(ctx, status, model); mapErr != nil {
+			diags.AddError("Model Error", fmt.Sprintf("Unable to read connector status, got error: %s", mapErr))
+		}
+		model.State = desiredState
+	}
+	if err != nil {
+		diags.AddError("Connector Not Running", fmt.Sprintf("Connector %s did not become healthy, got error: %s", connector.Metadata.Name, err))
+	}
+	return diags
+}
+
 func (r *ConnectorV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
 
@@ -38,6 +38,9 @@ func TestAccConnectorV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.tasks.max", "1"),
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.topic", "click.pageviews"),
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.file", "/etc/kafka/consumer.properties"),
+					resource.TestCheckResourceAttr(resourceRef, "state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceRef, "wait_for_running.poll_interval", "5s"),
+					resource.TestCheckResourceAttr(resourceRef, "tasks.0.state", "RUNNING"),
 				),
 			},
 			// Importing matches the state of the previous step.
@@ -47,6 +50,8 @@ func TestAccConnectorV2Resource(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateId:                        "kafka-cluster/kafka-connect/connector-test",
 				ImportStateVerifyIdentifierAttribute: "name",
+				// Task statuses are reported by Kafka Connect and may change between reads, wait_for_running is not sent to Console.
+				ImportStateVerifyIgnore: []string{"tasks", "wait_for_running"},
 			},
 			// Update and Read testing
 			{
@@ -64,6 +69,8 @@ func TestAccConnectorV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.tasks.max", "2"),
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.topic", "click.pageviews.new"),
 					resource.TestCheckResourceAttr(resourceRef, "spec.config.file", "/etc/kafka/producer.properties"),
+					resource.TestCheckResourceAttr(resourceRef, "state", "PAUSED"),
+					resource.TestCheckResourceAttr(resourceRef, "restart_triggers.secret_version", "2"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
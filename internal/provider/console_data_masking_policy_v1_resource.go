@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_data_masking_policy_v1"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_data_masking_policy_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const dataMaskingPolicyV1ApiPath = "/public/data-masking/v1/policy"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DataMaskingPolicyV1Resource{}
+var _ resource.ResourceWithImportState = &DataMaskingPolicyV1Resource{}
+var _ resource.ResourceWithModifyPlan = &DataMaskingPolicyV1Resource{}
+var _ resource.ResourceWithValidateConfig = &DataMaskingPolicyV1Resource{}
+
+func NewDataMaskingPolicyV1Resource() resource.Resource {
+	return &DataMaskingPolicyV1Resource{}
+}
+
+// DataMaskingPolicyV1Resource defines the resource implementation.
+type DataMaskingPolicyV1Resource struct {
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// dataMaskingPolicyV1ResourceModel is the generated model along with the operation timeouts.
+type dataMaskingPolicyV1ResourceModel struct {
+	schema.ConsoleDataMaskingPolicyV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DataMaskingPolicyV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_data_masking_policy_v1"
+}
+
+func (r *DataMaskingPolicyV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, schema.ConsoleDataMaskingPolicyV1ResourceSchema(ctx))
+}
+
+func (r *DataMaskingPolicyV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *DataMaskingPolicyV1Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataMaskingPolicyV1ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !schemaUtils.AttrIsSet(data.Spec) || !schemaUtils.AttrIsSet(data.Spec.Rule) {
+		return
+	}
+	rule, diags := schema.NewRuleValue(data.Spec.Rule.AttributeTypes(ctx), data.Spec.Rule.Attributes())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || rule.RuleType.IsUnknown() || rule.NumberOfChars.IsUnknown() {
+		return
+	}
+
+	ruleType := rule.RuleType.ValueString()
+	numberOfCharsPath := path.Root("spec").AtName("rule").AtName("number_of_chars")
+	switch {
+	case ruleType == "MASK_ALL" && !rule.NumberOfChars.IsNull():
+		resp.Diagnostics.AddAttributeError(numberOfCharsPath, "Invalid Attribute Combination",
+			"number_of_chars can't be set for MASK_ALL rules, all the characters are masked")
+	case ruleType != "MASK_ALL" && rule.NumberOfChars.IsNull():
+		resp.Diagnostics.AddAttributeError(numberOfCharsPath, "Missing Attribute Configuration",
+			fmt.Sprintf("number_of_chars is required for %s rules", ruleType))
+	}
+}
+
+func (r *DataMaskingPolicyV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "data masking policy",
+		func(ctx context.Context, data *dataMaskingPolicyV1ResourceModel) (console.DataMaskingPolicyConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleDataMaskingPolicyV1Model)
+		},
+		staticPutPath[console.DataMaskingPolicyConsoleResource](dataMaskingPolicyV1ApiPath))
+}
+
+func (r *DataMaskingPolicyV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataMaskingPolicyV1ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Creating data masking policy named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Create data masking policy with desired state : %+v", data))
+
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleDataMaskingPolicyV1Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create data masking policy, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Data masking policy to create : %+v", consoleResource))
+
+	resp.Diagnostics.Append(checkExemptedGroups(ctx, r.apiClient, consoleResource.Spec.ExemptedGroups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apply, err := r.apiClient.Apply(ctx, dataMaskingPolicyV1ApiPath, consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create data masking policy, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Data masking policy created with result: %s", apply.UpsertResult))
+
+	var consoleRes = console.DataMaskingPolicyConsoleResource{}
+	err = consoleRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as data masking policy : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New data masking policy state : %+v", consoleRes))
+
+	data.ConsoleDataMaskingPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read data masking policy, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataMaskingPolicyV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataMaskingPolicyV1ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Read data masking policy named %s", data.Name.String()))
+	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", dataMaskingPolicyV1ApiPath, data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data masking policy, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Data masking policy %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var consoleRes = console.DataMaskingPolicyConsoleResource{}
+	err = jsoniter.Unmarshal(get, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read data masking policy, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New data masking policy state : %+v", consoleRes))
+
+	data.ConsoleDataMaskingPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read data masking policy, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataMaskingPolicyV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataMaskingPolicyV1ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Updating data masking policy named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update data masking policy with TF data: %+v", data))
+
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleDataMaskingPolicyV1Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to update data masking policy, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Data masking policy to update : %+v", consoleResource))
+
+	resp.Diagnostics.Append(checkExemptedGroups(ctx, r.apiClient, consoleResource.Spec.ExemptedGroups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apply, err := r.apiClient.Apply(ctx, dataMaskingPolicyV1ApiPath, consoleResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update data masking policy, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Data masking policy updated with result: %s", apply))
+
+	var consoleRes = console.DataMaskingPolicyConsoleResource{}
+	err = consoleRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as data masking policy : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New data masking policy state : %+v", consoleRes))
+
+	data.ConsoleDataMaskingPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read data masking policy, got error: %s", err))
+		return
+	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataMaskingPolicyV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataMaskingPolicyV1ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting data masking policy named %s", data.Name.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resourcePath := fmt.Sprintf("%s/%s", dataMaskingPolicyV1ApiPath, data.Name.ValueString())
+	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data masking policy, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Data masking policy %s deleted", data.Name.String()))
+}
+
+func (r *DataMaskingPolicyV1Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// checkExemptedGroups reports the exempted groups that don't exist in Console. Groups are checked on apply rather
+// than on plan, for groups created along with the policy to exist by then.
+func checkExemptedGroups(ctx context.Context, apiClient *client.Client, groups []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, group := range groups {
+		tflog.Debug(ctx, fmt.Sprintf("Checking exempted group %s exists", group))
+		get, err := apiClient.Describe(ctx, fmt.Sprintf("%s/%s", groupV2ApiPath, group))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read exempted group %s, got error: %s", group, err))
+			return diags
+		}
+		if len(get) == 0 {
+			diags.AddAttributeError(path.Root("spec").AtName("exempted_groups"), "Exempted group not found",
+				fmt.Sprintf("Group %s doesn't exist in Console, it must be created before being exempted from the data masking policy.", group))
+		}
+	}
+	return diags
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckExemptedGroups(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api"+groupV2ApiPath+"/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path != "/api"+groupV2ApiPath+"/compliance" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Group","metadata":{"name":"compliance"}}`))
+	}))
+	t.Cleanup(ts.Close)
+	consoleClient, err := client.Make(context.Background(), client.CONSOLE, client.ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key"}, "test")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		groups []string
+		errors []string
+	}{
+		{name: "no group"},
+		{name: "existing group", groups: []string{"compliance"}},
+		{name: "missing groups", groups: []string{"compliance", "legal", "audit"}, errors: []string{"Exempted group not found", "Exempted group not found"}},
+		{name: "unreadable group", groups: []string{"broken", "legal"}, errors: []string{"Client Error"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkExemptedGroups(context.Background(), consoleClient, tt.groups)
+			var errors []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			assert.Equal(t, tt.errors, errors)
+		})
+	}
+}
+
+func TestAccDataMaskingPolicyV1Resource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resourceRef := "conduktor_console_data_masking_policy_v1.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Rule missing its number of characters is rejected
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/data_masking_policy_v1/resource_not_valid.tf"),
+				ExpectError: regexp.MustCompile("number_of_chars is required for MASK_LAST_N rules"),
+			},
+			// Exempted group not existing is rejected
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/data_masking_policy_v1/resource_missing_group.tf"),
+				ExpectError: regexp.MustCompile("Exempted group not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/data_masking_policy_v1/resource_create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "mask-pii"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.description", "Mask customer personal data"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.fields.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceRef, "spec.fields.*", "customer.email"),
+					resource.TestCheckTypeSetElemAttr(resourceRef, "spec.fields.*", "customer.phone"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.rule.type", "MASK_ALL"),
+					resource.TestCheckNoResourceAttr(resourceRef, "spec.rule.number_of_chars"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.exempted_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.exempted_groups.0", "compliance"),
+				),
+			},
+			// Importing matches the state of the previous step.
+			{
+				ResourceName:                         resourceRef,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "mask-pii",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/data_masking_policy_v1/resource_update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "mask-pii"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.description", "Mask the start of customer personal data"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.fields.#", "3"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.rule.type", "MASK_FIRST_N"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.rule.masking_char", "#"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.rule.number_of_chars", "4"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.exempted_groups.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDataMaskingPolicyV1ExampleResource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read from simple example
+			{
+				Config: providerConfigConsole + test.TestAccExample(t, "resources", "conduktor_console_data_masking_policy_v1", "simple.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_console_data_masking_policy_v1.simple", "name", "mask-emails"),
+					resource.TestCheckResourceAttr("conduktor_console_data_masking_policy_v1.simple", "spec.rule.type", "MASK_ALL"),
+				),
+			},
+			// Create and Read from complex example
+			{
+				Config: providerConfigConsole + test.TestAccExample(t, "resources", "conduktor_console_data_masking_policy_v1", "complex.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_console_data_masking_policy_v1.complex", "name", "mask-payment-data"),
+					resource.TestCheckResourceAttr("conduktor_console_data_masking_policy_v1.complex", "spec.rule.type", "MASK_LAST_N"),
+					resource.TestCheckResourceAttr("conduktor_console_data_masking_policy_v1.complex", "spec.exempted_groups.#", "2"),
+				),
+			},
+		},
+	})
+}
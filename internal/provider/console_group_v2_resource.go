@@ -9,6 +9,7 @@ import (
 	"github.com/conduktor/terraform-provider-conduktor/internal/model"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_group_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +21,7 @@ const groupV2ApiPath = "/public/iam/v2/group"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupV2Resource{}
 var _ resource.ResourceWithImportState = &GroupV2Resource{}
+var _ resource.ResourceWithModifyPlan = &GroupV2Resource{}
 
 func NewGroupV2Resource() resource.Resource {
 	return &GroupV2Resource{}
@@ -27,7 +29,14 @@ func NewGroupV2Resource() resource.Resource {
 
 // GroupV2Resource defines the resource implementation.
 type GroupV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// groupV2ResourceModel is the generated model along with the operation timeouts.
+type groupV2ResourceModel struct {
+	schema.ConsoleGroupV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GroupV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +44,7 @@ func (r *GroupV2Resource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *GroupV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleGroupV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleGroupV2ResourceSchema(ctx))
 }
 
 func (r *GroupV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,21 +64,31 @@ func (r *GroupV2Resource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *GroupV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "group",
+		func(ctx context.Context, data *groupV2ResourceModel) (console.GroupConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleGroupV2Model)
+		},
+		staticPutPath[console.GroupConsoleResource](groupV2ApiPath))
 }
 
 func (r *GroupV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleGroupV2Model
+	var data groupV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -78,10 +97,18 @@ func (r *GroupV2Resource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating group named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create group with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleGroupV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create group, got error: %s", err))
 		return
@@ -107,7 +134,7 @@ func (r *GroupV2Resource) Create(ctx context.Context, req resource.CreateRequest
 	consoleRes.Spec.Permissions = model.MergeWithPlannedPermissions(consoleResource.Spec.Permissions, consoleRes.Spec.Permissions)
 	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
 		return
@@ -118,7 +145,7 @@ func (r *GroupV2Resource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *GroupV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleGroupV2Model
+	var data groupV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -127,6 +154,14 @@ func (r *GroupV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read group named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", groupV2ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -148,7 +183,7 @@ func (r *GroupV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
 		return
@@ -159,7 +194,7 @@ func (r *GroupV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *GroupV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleGroupV2Model
+	var data groupV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -168,10 +203,18 @@ func (r *GroupV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating group named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update group with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleGroupV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create group, got error: %s", err))
 		return
@@ -196,7 +239,7 @@ func (r *GroupV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 	consoleRes.Spec.Permissions = model.MergeWithPlannedPermissions(consoleResource.Spec.Permissions, consoleRes.Spec.Permissions)
 	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
 		return
@@ -206,7 +249,7 @@ func (r *GroupV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *GroupV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleGroupV2Model
+	var data groupV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -217,6 +260,14 @@ func (r *GroupV2Resource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", groupV2ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_kafka_cluster_v2"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_cluster_v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KafkaClusterV2DataSource{}
+var _ datasource.DataSourceWithConfigure = &KafkaClusterV2DataSource{}
+
+func NewKafkaClusterV2DataSource() datasource.DataSource {
+	return &KafkaClusterV2DataSource{}
+}
+
+// KafkaClusterV2DataSource defines the data source implementation.
+type KafkaClusterV2DataSource struct {
+	apiClient *client.Client
+}
+
+func (d *KafkaClusterV2DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_kafka_cluster_v2"
+}
+
+func (d *KafkaClusterV2DataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Same attributes as the resource so values can be passed from one to the other, but all read-only.
+	resourceSchema := schema.ConsoleKafkaClusterV2ResourceSchema(ctx)
+	attributes, err := schemaUtils.DataSourceAttributesFromResource(resourceSchema.Attributes, "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("Unable to build kafka cluster data source schema, got error: %s", err))
+		return
+	}
+	attributes["name"] = dschema.StringAttribute{
+		Required:            true,
+		Description:         "Name of the Kafka cluster to read",
+		MarkdownDescription: "Name of the Kafka cluster to read",
+	}
+
+	resp.Schema = dschema.Schema{
+		Description:         "Read an existing Kafka cluster declared in Conduktor Console.",
+		MarkdownDescription: "Read an existing Kafka cluster declared in Conduktor Console.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *KafkaClusterV2DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this data source. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	d.apiClient = apiClient
+}
+
+func (d *KafkaClusterV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schema.ConsoleKafkaClusterV2Model
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read kafka cluster named %s", data.Name.String()))
+	get, err := d.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", kafkaClusterV2ApiPath, data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Kafka cluster not found",
+			fmt.Sprintf("No kafka cluster named %s found in Console", data.Name.String()),
+		)
+		return
+	}
+
+	var consoleRes = console.KafkaClusterResource{}
+	err = jsoniter.Unmarshal(get, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+
+	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
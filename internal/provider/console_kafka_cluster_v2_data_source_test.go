@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKafkaClusterV2DataSource(t *testing.T) {
+	dataSourceRef := "data.conduktor_console_kafka_cluster_v2.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/kafka_cluster_v2/data_source.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceRef, "name", "data-source-cluster"),
+					resource.TestCheckResourceAttr(dataSourceRef, "labels.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceRef, "labels.env", "test"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.display_name", "Data Source Cluster"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.bootstrap_servers", "localhost:9092"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.kafka_flavor.confluent.key", "confluent-key"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.kafka_flavor.confluent.secret", "confluent-secret"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.kafka_flavor.confluent.confluent_cluster_id", "confluent-cluster-id"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.kafka_flavor.confluent.confluent_environment_id", "confluent-environment-id"),
+					resource.TestCheckNoResourceAttr(dataSourceRef, "spec.kafka_flavor.aiven"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.schema_registry.confluent_like.url", "http://localhost:8081"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.schema_registry.confluent_like.security.basic_auth.username", "user"),
+					resource.TestCheckResourceAttr(dataSourceRef, "spec.schema_registry.confluent_like.security.basic_auth.password", "password"),
+					resource.TestCheckNoResourceAttr(dataSourceRef, "spec.schema_registry.glue"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaClusterV2DataSourceNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/kafka_cluster_v2/data_source_not_found.tf"),
+				ExpectError: regexp.MustCompile(`Kafka cluster not found`),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_kafka_cluster_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_cluster_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +20,7 @@ const kafkaClusterV2ApiPath = "/public/console/v2/kafka-cluster"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &KafkaClusterV2Resource{}
 var _ resource.ResourceWithImportState = &KafkaClusterV2Resource{}
+var _ resource.ResourceWithModifyPlan = &KafkaClusterV2Resource{}
 var _ resource.ResourceWithConfigValidators = &KafkaClusterV2Resource{}
 
 func NewKafkaClusterV2Resource() resource.Resource {
@@ -27,7 +29,15 @@ func NewKafkaClusterV2Resource() resource.Resource {
 
 // KafkaClusterV2Resource defines the resource implementation.
 type KafkaClusterV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// kafkaClusterV2ResourceModel is the generated model along with the write-only secrets and the operation timeouts.
+type kafkaClusterV2ResourceModel struct {
+	schema.ConsoleKafkaClusterV2Model
+	mapper.WriteOnlySecrets
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KafkaClusterV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +45,16 @@ func (r *KafkaClusterV2Resource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *KafkaClusterV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleKafkaClusterV2ResourceSchema(ctx)
+	resp.Schema = withWriteOnlySecrets(withTimeouts(ctx, schema.ConsoleKafkaClusterV2ResourceSchema(ctx)),
+		writeOnlySecret{name: "confluent_secret_wo", description: "Confluent API secret, write-only variant of `spec.kafka_flavor.confluent.secret`."},
+		writeOnlySecret{name: "aiven_api_token_wo", description: "Aiven API token, write-only variant of `spec.kafka_flavor.aiven.api_token`."},
+		writeOnlySecret{name: "gateway_password_wo", description: "Conduktor Gateway Admin password, write-only variant of `spec.kafka_flavor.gateway.password`."},
+		writeOnlySecret{name: "schema_registry_password_wo", description: "Schema registry basic auth password, write-only variant of `spec.schema_registry.confluent_like.security.basic_auth.password`."},
+		writeOnlySecret{name: "schema_registry_token_wo", description: "Schema registry bearer token, write-only variant of `spec.schema_registry.confluent_like.security.bearer_token.token`."},
+		writeOnlySecret{name: "schema_registry_ssl_key_wo", description: "Schema registry SSL auth key, write-only variant of `spec.schema_registry.confluent_like.security.ssl_auth.key`."},
+		writeOnlySecret{name: "schema_registry_secret_key_wo", description: "AWS Glue secret key, write-only variant of `spec.schema_registry.glue.security.credentials.secret_key`."},
+		writeOnlySecret{name: "properties_wo", description: "Kafka client properties holding secrets, like `sasl.jaas.config`, merged over `spec.properties` on apply. Keys must not be repeated in `spec.properties`.", isMap: true},
+	)
 }
 
 func (r *KafkaClusterV2Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,17 +74,19 @@ func (r *KafkaClusterV2Resource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
 }
 
 func (r *KafkaClusterV2Resource) ConfigValidators(_ctx context.Context) []resource.ConfigValidator {
@@ -93,25 +114,54 @@ func (r *KafkaClusterV2Resource) ConfigValidators(_ctx context.Context) []resour
 	}
 }
 
+func (r *KafkaClusterV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "kafka cluster",
+		func(ctx context.Context, data *kafkaClusterV2ResourceModel) (console.KafkaClusterResource, error) {
+			var config kafkaClusterV2ResourceModel
+			resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+			consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaClusterV2Model)
+			if err == nil {
+				err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+			}
+			return consoleResource, err
+		},
+		staticPutPath[console.KafkaClusterResource](kafkaClusterV2ApiPath))
+}
+
 func (r *KafkaClusterV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleKafkaClusterV2Model
+	var data, config kafkaClusterV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("Creating kafka cluster named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create kafka cluster with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka cluster, got error: %s", err))
 		return
 	}
+	// Logged before the write-only secrets are set so that they never reach the logs.
 	tflog.Debug(ctx, fmt.Sprintf("Kafka cluster to create : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka cluster, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, kafkaClusterV2ApiPath, consoleResource)
 	if err != nil {
@@ -127,9 +177,13 @@ func (r *KafkaClusterV2Resource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as kafka cluster : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka cluster state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	err = mapper.ForgetWriteOnlySecrets(ctx, &consoleRes, &data.ConsoleKafkaClusterV2Model, data.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+	data.ConsoleKafkaClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
 		return
@@ -140,7 +194,7 @@ func (r *KafkaClusterV2Resource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *KafkaClusterV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleKafkaClusterV2Model
+	var data kafkaClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -149,6 +203,14 @@ func (r *KafkaClusterV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read kafka cluster named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", kafkaClusterV2ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -168,9 +230,13 @@ func (r *KafkaClusterV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka cluster state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	err = mapper.ForgetWriteOnlySecrets(ctx, &consoleRes, &data.ConsoleKafkaClusterV2Model, data.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+	data.ConsoleKafkaClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
 		return
@@ -181,31 +247,45 @@ func (r *KafkaClusterV2Resource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *KafkaClusterV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleKafkaClusterV2Model
+	var data, config kafkaClusterV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("Updating kafka cluster named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update kafka cluster with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka cluster, got error: %s", err))
 		return
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Kafka cluster to update : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka cluster, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, kafkaClusterV2ApiPath, consoleResource)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create kafka cluster, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Kafka cluster updated with result: %s", apply))
+	tflog.Debug(ctx, fmt.Sprintf("Kafka cluster updated with result: %s", apply.UpsertResult))
 
 	var consoleRes = console.KafkaClusterResource{}
 	err = consoleRes.FromRawJsonInterface(apply.Resource)
@@ -213,9 +293,13 @@ func (r *KafkaClusterV2Resource) Update(ctx context.Context, req resource.Update
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as kafka cluster : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka cluster state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	err = mapper.ForgetWriteOnlySecrets(ctx, &consoleRes, &data.ConsoleKafkaClusterV2Model, data.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
+		return
+	}
+	data.ConsoleKafkaClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka cluster, got error: %s", err))
 		return
@@ -225,7 +309,7 @@ func (r *KafkaClusterV2Resource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *KafkaClusterV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleKafkaClusterV2Model
+	var data kafkaClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -236,6 +320,14 @@ func (r *KafkaClusterV2Resource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", kafkaClusterV2ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
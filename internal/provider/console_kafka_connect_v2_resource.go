@@ -7,6 +7,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_kafka_connect_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_connect_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ func kafkaConnectV2ApiGetPath(cluster string, connectServerName string) string {
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &KafkaConnectV2Resource{}
 var _ resource.ResourceWithImportState = &KafkaConnectV2Resource{}
+var _ resource.ResourceWithModifyPlan = &KafkaConnectV2Resource{}
 var _ resource.ResourceWithConfigValidators = &KafkaConnectV2Resource{}
 
 func NewKafkaConnectV2Resource() resource.Resource {
@@ -34,7 +36,15 @@ func NewKafkaConnectV2Resource() resource.Resource {
 
 // KafkaConnectV2Resource defines the resource implementation.
 type KafkaConnectV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// kafkaConnectV2ResourceModel is the generated model along with the write-only secrets and the operation timeouts.
+type kafkaConnectV2ResourceModel struct {
+	schema.ConsoleKafkaConnectV2Model
+	mapper.WriteOnlySecrets
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KafkaConnectV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +52,7 @@ func (r *KafkaConnectV2Resource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *KafkaConnectV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleKafkaConnectV2ResourceSchema(ctx)
+	resp.Schema = withWriteOnlySecrets(withTimeouts(ctx, schema.ConsoleKafkaConnectV2ResourceSchema(ctx)), securityWriteOnlySecrets...)
 }
 
 func (r *KafkaConnectV2Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,17 +72,19 @@ func (r *KafkaConnectV2Resource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
 }
 
 func (r *KafkaConnectV2Resource) ConfigValidators(_ctx context.Context) []resource.ConfigValidator {
@@ -85,25 +97,56 @@ func (r *KafkaConnectV2Resource) ConfigValidators(_ctx context.Context) []resour
 	}
 }
 
+func (r *KafkaConnectV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "kafka connect server",
+		func(ctx context.Context, data *kafkaConnectV2ResourceModel) (console.KafkaConnectResource, error) {
+			var config kafkaConnectV2ResourceModel
+			resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+			consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaConnectV2Model)
+			if err == nil {
+				err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+			}
+			return consoleResource, err
+		},
+		func(res console.KafkaConnectResource) (string, error) {
+			return kafkaConnectV2ApiPutPath(res.Metadata.Cluster), nil
+		})
+}
+
 func (r *KafkaConnectV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleKafkaConnectV2Model
+	var data, config kafkaConnectV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("Creating kafka connect server named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create kafka connect server with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaConnectV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka connect server, got error: %s", err))
 		return
 	}
+	// Logged before the write-only secrets are set so that they never reach the logs.
 	tflog.Debug(ctx, fmt.Sprintf("Kafka connect server to create : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka connect server, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, kafkaConnectV2ApiPutPath(consoleResource.Metadata.Cluster), consoleResource)
 	if err != nil {
@@ -119,9 +162,9 @@ func (r *KafkaConnectV2Resource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as kafka connect server : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka connect server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKafkaConnectV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka connect server, got error: %s", err))
 		return
@@ -132,7 +175,7 @@ func (r *KafkaConnectV2Resource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *KafkaConnectV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleKafkaConnectV2Model
+	var data kafkaConnectV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -141,6 +184,14 @@ func (r *KafkaConnectV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read kafka connect server named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, kafkaConnectV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString()))
 	if err != nil {
@@ -160,9 +211,9 @@ func (r *KafkaConnectV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read kafka connect server, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka connect server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKafkaConnectV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka connect server, got error: %s", err))
 		return
@@ -173,31 +224,45 @@ func (r *KafkaConnectV2Resource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *KafkaConnectV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleKafkaConnectV2Model
+	var data, config kafkaConnectV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating kafka connect server named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update kafka connect server with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaConnectV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka connect server, got error: %s", err))
 		return
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Kafka connect server to update : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka connect server, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, kafkaConnectV2ApiPutPath(consoleResource.Metadata.Cluster), consoleResource)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create kafka connect server, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Kafka connect server updated with result: %s", apply))
+	tflog.Debug(ctx, fmt.Sprintf("Kafka connect server updated with result: %s", apply.UpsertResult))
 
 	var consoleRes = console.KafkaConnectResource{}
 	err = consoleRes.FromRawJsonInterface(apply.Resource)
@@ -205,9 +270,9 @@ func (r *KafkaConnectV2Resource) Update(ctx context.Context, req resource.Update
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as kafka connect server : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New kafka connect server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKafkaConnectV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read kafka connect server, got error: %s", err))
 		return
@@ -217,7 +282,7 @@ func (r *KafkaConnectV2Resource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *KafkaConnectV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleKafkaConnectV2Model
+	var data kafkaConnectV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -228,6 +293,14 @@ func (r *KafkaConnectV2Resource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := kafkaConnectV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
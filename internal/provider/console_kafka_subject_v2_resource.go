@@ -7,9 +7,14 @@ import (
 	"time"
 
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/customtypes"
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_kafka_subject_v2"
 	"github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_subject_v2"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schemacompat"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,13 +35,22 @@ const kafkaSubjectMininumVersion = "v1.29.0"
 
 var _ resource.Resource = &KafkaSubjectV2Resource{}
 var _ resource.ResourceWithImportState = &KafkaSubjectV2Resource{}
+var _ resource.ResourceWithModifyPlan = &KafkaSubjectV2Resource{}
 
 func NewKafkaSubjectV2Resource() resource.Resource {
 	return &KafkaSubjectV2Resource{}
 }
 
 type KafkaSubjectV2Resource struct {
-	apiClient *client.Client
+	apiClient                *client.Client
+	validateOnPlan           bool
+	evaluateResourcePolicies bool
+}
+
+// kafkaSubjectV2ResourceModel is the generated model along with the operation timeouts.
+type kafkaSubjectV2ResourceModel struct {
+	schema.ConsoleKafkaSubjectV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KafkaSubjectV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +58,7 @@ func (r *KafkaSubjectV2Resource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *KafkaSubjectV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleKafkaSubjectV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleKafkaSubjectV2ResourceSchema(ctx))
 }
 
 func (r *KafkaSubjectV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -64,17 +78,18 @@ func (r *KafkaSubjectV2Resource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
@@ -87,11 +102,91 @@ func (r *KafkaSubjectV2Resource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+	r.evaluateResourcePolicies = data.EvaluateResourcePolicies
+}
+
+func (r *KafkaSubjectV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Local compatibility checks don't need the API, they run whether plan validation is enabled or not.
+	checkSubjectSchemaCompatibility(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var checks []func(console.KafkaSubjectResource) diag.Diagnostics
+	if r.evaluateResourcePolicies {
+		checks = append(checks, func(res console.KafkaSubjectResource) diag.Diagnostics {
+			return evaluateResourcePolicies(ctx, r.apiClient, res, ownedBy(res.Metadata.Cluster, "SUBJECT", res.Metadata.Name))
+		})
+	}
+
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "kafka subject",
+		func(ctx context.Context, data *kafkaSubjectV2ResourceModel) (console.KafkaSubjectResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleKafkaSubjectV2Model)
+		},
+		func(res console.KafkaSubjectResource) (string, error) {
+			return kafkaSubjectV2ApiPutPath(res.Metadata.Cluster), nil
+		}, checks...)
+}
+
+// checkSubjectSchemaCompatibility reports the breaking changes between the schema in state and the planned one under
+// the compatibility mode of the subject. Transitive modes are only checked against the version in state.
+// The check is skipped when a schema can't be parsed locally, for instance when it depends on references.
+func checkSubjectSchemaCompatibility(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan kafkaSubjectV2ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A renamed subject is a new one, without any previous version to be compatible with.
+	if !plan.Name.Equal(state.Name) || !plan.Cluster.Equal(state.Cluster) {
+		return
+	}
+	if !schemaUtils.AttrIsSet(state.Spec.Schema) || !schemaUtils.AttrIsSet(plan.Spec.Schema) {
+		return
+	}
+	previous, next := state.Spec.Schema.ValueString(), plan.Spec.Schema.ValueString()
+	if equal, _ := customtypes.SchemaEqual(previous, next); equal {
+		return
+	}
+
+	// Compatibility is computed by Console when not set, the one in state then still applies.
+	compatibility := plan.Spec.Compatibility
+	if !schemaUtils.AttrIsSet(compatibility) {
+		compatibility = state.Spec.Compatibility
+	}
+	if !schemaUtils.AttrIsSet(compatibility) {
+		return
+	}
+
+	format := plan.Spec.Format.ValueString()
+	if !schemaUtils.AttrIsSet(plan.Spec.Format) {
+		format = customtypes.DetectSchemaFormat(next)
+	}
+
+	incompatibilities, err := schemacompat.Check(format, compatibility.ValueString(), previous, next)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to check kafka subject schema compatibility on plan, got error: %s", err))
+		return
+	}
+	for _, incompatibility := range incompatibilities {
+		detail := fmt.Sprintf("Under %s compatibility, %s.", compatibility.ValueString(), incompatibility.Message)
+		if incompatibility.Path != "" {
+			detail = fmt.Sprintf("Under %s compatibility, field `%s`: %s.", compatibility.ValueString(), incompatibility.Path, incompatibility.Message)
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("spec").AtName("schema"), "Incompatible schema change", detail)
+	}
 }
 
 func (r *KafkaSubjectV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleKafkaSubjectV2Model
+	var data kafkaSubjectV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -100,10 +195,18 @@ func (r *KafkaSubjectV2Resource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating kafka subject named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create kafka subject with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKafkaSubjectV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka subject, got error: %s", err))
 		return
@@ -127,11 +230,12 @@ func (r *KafkaSubjectV2Resource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	data.ConsoleKafkaSubjectV2Model = newState
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *KafkaSubjectV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleKafkaSubjectV2Model
+	var data kafkaSubjectV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -140,6 +244,14 @@ func (r *KafkaSubjectV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read kafka subject named %s", data.Name.String()))
 
 	newState, err := r.getSubjectState(ctx, data.Cluster.ValueString(), data.Name.ValueString())
@@ -149,12 +261,13 @@ func (r *KafkaSubjectV2Resource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	data.ConsoleKafkaSubjectV2Model = newState
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *KafkaSubjectV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan schema.ConsoleKafkaSubjectV2Model
-	var state schema.ConsoleKafkaSubjectV2Model
+	var plan kafkaSubjectV2ResourceModel
+	var state kafkaSubjectV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -164,6 +277,14 @@ func (r *KafkaSubjectV2Resource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Check if schema is changed to determine if we need to poll for a new version
 	isSchemaSame, diag := state.Spec.Schema.StringSemanticEquals(ctx, plan.Spec.Schema)
 	if diag.HasError() {
@@ -174,7 +295,7 @@ func (r *KafkaSubjectV2Resource) Update(ctx context.Context, req resource.Update
 	tflog.Info(ctx, fmt.Sprintf("Updating kafka subject named %s", plan.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update kafka subject with TF data: %+v", plan))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &plan)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &plan.ConsoleKafkaSubjectV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create kafka subject, got error: %s", err))
 		return
@@ -202,11 +323,12 @@ func (r *KafkaSubjectV2Resource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	plan.ConsoleKafkaSubjectV2Model = newState
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *KafkaSubjectV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleKafkaSubjectV2Model
+	var data kafkaSubjectV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -217,6 +339,14 @@ func (r *KafkaSubjectV2Resource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := kafkaSubjectV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -269,7 +399,11 @@ func (r *KafkaSubjectV2Resource) pollSubjectState(ctx context.Context, clusterNa
 		}
 
 		tflog.Debug(ctx, fmt.Sprintf("Kafka subject %s not updated yet (attempt %d/%d), current version: %v, previous version: %v, sleep %s", subjectName, i+1, maxRetries, newState.Spec.Version, *previousVersion, sleepTime))
-		time.Sleep(sleepTime)
+		select {
+		case <-ctx.Done():
+			return schema.ConsoleKafkaSubjectV2Model{}, fmt.Errorf("interrupted while waiting for kafka subject %s to update: %s", subjectName, ctx.Err())
+		case <-time.After(sleepTime):
+		}
 	}
 
 	return schema.ConsoleKafkaSubjectV2Model{}, fmt.Errorf("timeout waiting for kafka subject %s to update after %d retries", subjectName, maxRetries)
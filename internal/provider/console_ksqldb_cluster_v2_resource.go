@@ -7,6 +7,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_ksqldb_cluster_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_ksqldb_cluster_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ func ksqldbClusterV2ApiGetPath(cluster string, ksqldbClusterName string) string
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &KsqlDBClusterV2Resource{}
 var _ resource.ResourceWithImportState = &KsqlDBClusterV2Resource{}
+var _ resource.ResourceWithModifyPlan = &KsqlDBClusterV2Resource{}
 var _ resource.ResourceWithConfigValidators = &KsqlDBClusterV2Resource{}
 
 func NewKsqlDBClusterV2Resource() resource.Resource {
@@ -34,7 +36,15 @@ func NewKsqlDBClusterV2Resource() resource.Resource {
 
 // KsqlDBClusterV2Resource defines the resource implementation.
 type KsqlDBClusterV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// ksqlDBClusterV2ResourceModel is the generated model along with the write-only secrets and the operation timeouts.
+type ksqlDBClusterV2ResourceModel struct {
+	schema.ConsoleKsqldbClusterV2Model
+	mapper.WriteOnlySecrets
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KsqlDBClusterV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +52,7 @@ func (r *KsqlDBClusterV2Resource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *KsqlDBClusterV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleKsqldbClusterV2ResourceSchema(ctx)
+	resp.Schema = withWriteOnlySecrets(withTimeouts(ctx, schema.ConsoleKsqldbClusterV2ResourceSchema(ctx)), securityWriteOnlySecrets...)
 }
 
 func (r *KsqlDBClusterV2Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,17 +72,19 @@ func (r *KsqlDBClusterV2Resource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
 }
 
 func (r *KsqlDBClusterV2Resource) ConfigValidators(_ctx context.Context) []resource.ConfigValidator {
@@ -85,25 +97,56 @@ func (r *KsqlDBClusterV2Resource) ConfigValidators(_ctx context.Context) []resou
 	}
 }
 
+func (r *KsqlDBClusterV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "KsqlDB cluster server",
+		func(ctx context.Context, data *ksqlDBClusterV2ResourceModel) (console.KsqlDBClusterResource, error) {
+			var config ksqlDBClusterV2ResourceModel
+			resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+			consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKsqldbClusterV2Model)
+			if err == nil {
+				err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+			}
+			return consoleResource, err
+		},
+		func(res console.KsqlDBClusterResource) (string, error) {
+			return ksqldbClusterV2ApiPutPath(res.Metadata.Cluster), nil
+		})
+}
+
 func (r *KsqlDBClusterV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleKsqldbClusterV2Model
+	var data, config ksqlDBClusterV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("Creating KsqlDB cluster server named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create KsqlDB cluster server with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKsqldbClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create KsqlDB cluster server, got error: %s", err))
 		return
 	}
+	// Logged before the write-only secrets are set so that they never reach the logs.
 	tflog.Debug(ctx, fmt.Sprintf("KsqlDB cluster server to create : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create KsqlDB cluster server, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, ksqldbClusterV2ApiPutPath(consoleResource.Metadata.Cluster), consoleResource)
 	if err != nil {
@@ -119,9 +162,9 @@ func (r *KsqlDBClusterV2Resource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as KsqlDB cluster server : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New KsqlDB cluster server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKsqldbClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read KsqlDB cluster server, got error: %s", err))
 		return
@@ -132,7 +175,7 @@ func (r *KsqlDBClusterV2Resource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *KsqlDBClusterV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleKsqldbClusterV2Model
+	var data ksqlDBClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -141,6 +184,14 @@ func (r *KsqlDBClusterV2Resource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read KsqlDB cluster server named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, ksqldbClusterV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString()))
 	if err != nil {
@@ -160,9 +211,9 @@ func (r *KsqlDBClusterV2Resource) Read(ctx context.Context, req resource.ReadReq
 		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to read KsqlDB cluster server, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New KsqlDB cluster server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKsqldbClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read KsqlDB cluster server, got error: %s", err))
 		return
@@ -173,31 +224,45 @@ func (r *KsqlDBClusterV2Resource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *KsqlDBClusterV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleKsqldbClusterV2Model
+	var data, config ksqlDBClusterV2ResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, write-only secrets are only available in the configuration
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating KsqlDB cluster server named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update KsqlDB cluster server with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleKsqldbClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create KsqlDB cluster server, got error: %s", err))
 		return
 	}
 	tflog.Debug(ctx, fmt.Sprintf("KsqlDB cluster server to update : %+v", consoleResource))
+	err = mapper.ApplyWriteOnlySecrets(ctx, &consoleResource, config.WriteOnlySecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create KsqlDB cluster server, got error: %s", err))
+		return
+	}
 
 	apply, err := r.apiClient.Apply(ctx, ksqldbClusterV2ApiPutPath(consoleResource.Metadata.Cluster), consoleResource)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create KsqlDB cluster server, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("KsqlDB cluster server updated with result: %s", apply))
+	tflog.Debug(ctx, fmt.Sprintf("KsqlDB cluster server updated with result: %s", apply.UpsertResult))
 
 	var consoleRes = console.KsqlDBClusterResource{}
 	err = consoleRes.FromRawJsonInterface(apply.Resource)
@@ -205,9 +270,9 @@ func (r *KsqlDBClusterV2Resource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as KsqlDB cluster server : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New KsqlDB cluster server state : %+v", consoleRes))
+	mapper.ForgetWriteOnlySecrets(&consoleRes, data.WriteOnlySecrets)
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleKsqldbClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read KsqlDB cluster server, got error: %s", err))
 		return
@@ -217,7 +282,7 @@ func (r *KsqlDBClusterV2Resource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *KsqlDBClusterV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleKsqldbClusterV2Model
+	var data ksqlDBClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -228,6 +293,14 @@ func (r *KsqlDBClusterV2Resource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := ksqldbClusterV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_partner_zone_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_partner_zone_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +23,7 @@ const partnerZoneMininumGatewayVersion = "v3.6.1"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PartnerZoneV2Resource{}
 var _ resource.ResourceWithImportState = &PartnerZoneV2Resource{}
+var _ resource.ResourceWithModifyPlan = &PartnerZoneV2Resource{}
 
 func NewPartnerZoneV2Resource() resource.Resource {
 	return &PartnerZoneV2Resource{}
@@ -29,7 +31,14 @@ func NewPartnerZoneV2Resource() resource.Resource {
 
 // PartnerZoneV2Resource defines the resource implementation.
 type PartnerZoneV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// partnerZoneV2ResourceModel is the generated model along with the operation timeouts.
+type partnerZoneV2ResourceModel struct {
+	schema.ConsolePartnerZoneV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *PartnerZoneV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +46,7 @@ func (r *PartnerZoneV2Resource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *PartnerZoneV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsolePartnerZoneV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsolePartnerZoneV2ResourceSchema(ctx))
 }
 
 func (r *PartnerZoneV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,17 +66,18 @@ func (r *PartnerZoneV2Resource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Console version",
@@ -83,11 +93,20 @@ func (r *PartnerZoneV2Resource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *PartnerZoneV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "partner zone",
+		func(ctx context.Context, data *partnerZoneV2ResourceModel) (console.PartnerZoneConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsolePartnerZoneV2Model)
+		},
+		staticPutPath[console.PartnerZoneConsoleResource](partnerZoneV2ApiPath))
 }
 
 func (r *PartnerZoneV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsolePartnerZoneV2Model
+	var data partnerZoneV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -96,10 +115,18 @@ func (r *PartnerZoneV2Resource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating partner zone named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create partner zone with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsolePartnerZoneV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create partner zone, got error: %s", err))
 		return
@@ -121,7 +148,7 @@ func (r *PartnerZoneV2Resource) Create(ctx context.Context, req resource.CreateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New partner zone state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsolePartnerZoneV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read partner zone, got error: %s", err))
 		return
@@ -132,7 +159,7 @@ func (r *PartnerZoneV2Resource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *PartnerZoneV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsolePartnerZoneV2Model
+	var data partnerZoneV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -141,6 +168,14 @@ func (r *PartnerZoneV2Resource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read partner zone named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", partnerZoneV2ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -162,7 +197,7 @@ func (r *PartnerZoneV2Resource) Read(ctx context.Context, req resource.ReadReque
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New partner zone state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsolePartnerZoneV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read partner zone, got error: %s", err))
 		return
@@ -173,7 +208,7 @@ func (r *PartnerZoneV2Resource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *PartnerZoneV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsolePartnerZoneV2Model
+	var data partnerZoneV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -182,10 +217,18 @@ func (r *PartnerZoneV2Resource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating partner zone named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update partner zone with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsolePartnerZoneV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create partner zone, got error: %s", err))
 		return
@@ -207,7 +250,7 @@ func (r *PartnerZoneV2Resource) Update(ctx context.Context, req resource.UpdateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New partner zone state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsolePartnerZoneV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read partner zone, got error: %s", err))
 		return
@@ -217,7 +260,7 @@ func (r *PartnerZoneV2Resource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *PartnerZoneV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsolePartnerZoneV2Model
+	var data partnerZoneV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -228,6 +271,14 @@ func (r *PartnerZoneV2Resource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", partnerZoneV2ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -7,7 +7,10 @@ import (
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_resource_policy_v1"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/resourcepolicy"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_resource_policy_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +25,8 @@ const resourcePolicyEnterpriseOnlyVersion = "v1.43.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ResourcePolicyV1Resource{}
 var _ resource.ResourceWithImportState = &ResourcePolicyV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ResourcePolicyV1Resource{}
+var _ resource.ResourceWithValidateConfig = &ResourcePolicyV1Resource{}
 
 func NewResourcePolicyV1Resource() resource.Resource {
 	return &ResourcePolicyV1Resource{}
@@ -29,7 +34,14 @@ func NewResourcePolicyV1Resource() resource.Resource {
 
 // ResourcePolicyV1Resource defines the resource implementation.
 type ResourcePolicyV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// resourcePolicyV1ResourceModel is the generated model along with the operation timeouts.
+type resourcePolicyV1ResourceModel struct {
+	schema.ConsoleResourcePolicyV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ResourcePolicyV1Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +49,7 @@ func (r *ResourcePolicyV1Resource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (r *ResourcePolicyV1Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleResourcePolicyV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleResourcePolicyV1ResourceSchema(ctx))
 }
 
 func (r *ResourcePolicyV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,17 +69,18 @@ func (r *ResourcePolicyV1Resource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Console version",
@@ -83,16 +96,50 @@ func (r *ResourcePolicyV1Resource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, resourcePolicyEnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, resourcePolicyEnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *ResourcePolicyV1Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resourcePolicyV1ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !schemaUtils.AttrIsSet(data.Spec) || !schemaUtils.AttrIsSet(data.Spec.Rules) {
+		return
+	}
+
+	// Compile the rule conditions locally to report CEL syntax errors before any call to Console.
+	for _, element := range data.Spec.Rules.Elements() {
+		rule, ok := element.(schema.RulesValue)
+		if !ok || !schemaUtils.AttrIsSet(rule.Condition) {
+			continue
+		}
+		if _, err := resourcepolicy.Compile(rule.Condition.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("spec").AtName("rules").AtSetValue(element).AtName("condition"),
+				"Invalid CEL Condition",
+				fmt.Sprintf("Condition %q can't be compiled, got error: %s", rule.Condition.ValueString(), err),
+			)
+		}
+	}
+}
+
+func (r *ResourcePolicyV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "resource policy",
+		func(ctx context.Context, data *resourcePolicyV1ResourceModel) (console.ResourcePolicyConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleResourcePolicyV1Model)
+		},
+		staticPutPath[console.ResourcePolicyConsoleResource](resourcePolicyV1ApiPath))
 }
 
 func (r *ResourcePolicyV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleResourcePolicyV1Model
+	var data resourcePolicyV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -101,10 +148,18 @@ func (r *ResourcePolicyV1Resource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating resource policy named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create resource policy with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleResourcePolicyV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create resource policy, got error: %s", err))
 		return
@@ -127,7 +182,7 @@ func (r *ResourcePolicyV1Resource) Create(ctx context.Context, req resource.Crea
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New resource policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleResourcePolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read resource policy, got error: %s", err))
 		return
@@ -138,7 +193,7 @@ func (r *ResourcePolicyV1Resource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *ResourcePolicyV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleResourcePolicyV1Model
+	var data resourcePolicyV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -147,6 +202,14 @@ func (r *ResourcePolicyV1Resource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read resource policy named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", resourcePolicyV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -168,7 +231,7 @@ func (r *ResourcePolicyV1Resource) Read(ctx context.Context, req resource.ReadRe
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New resource policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleResourcePolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read resource policy, got error: %s", err))
 		return
@@ -179,7 +242,7 @@ func (r *ResourcePolicyV1Resource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ResourcePolicyV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleResourcePolicyV1Model
+	var data resourcePolicyV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -188,10 +251,18 @@ func (r *ResourcePolicyV1Resource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating resource policy named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update resource policy with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleResourcePolicyV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create resource policy, got error: %s", err))
 		return
@@ -213,7 +284,7 @@ func (r *ResourcePolicyV1Resource) Update(ctx context.Context, req resource.Upda
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New resource policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleResourcePolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read resource policy, got error: %s", err))
 		return
@@ -223,7 +294,7 @@ func (r *ResourcePolicyV1Resource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ResourcePolicyV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleResourcePolicyV1Model
+	var data resourcePolicyV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -234,6 +305,14 @@ func (r *ResourcePolicyV1Resource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", resourcePolicyV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_service_account_v1"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_service_account_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,6 +31,7 @@ const consoleServiceAccountMininumVersion = "v1.30.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ServiceAccountV1Resource{}
 var _ resource.ResourceWithImportState = &ServiceAccountV1Resource{}
+var _ resource.ResourceWithModifyPlan = &ServiceAccountV1Resource{}
 var _ resource.ResourceWithConfigValidators = &ServiceAccountV1Resource{}
 
 func NewServiceAccountV1Resource() resource.Resource {
@@ -38,7 +40,14 @@ func NewServiceAccountV1Resource() resource.Resource {
 
 // ServiceAccountV1Resource defines the resource implementation.
 type ServiceAccountV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// serviceAccountV1ResourceModel is the generated model along with the operation timeouts.
+type serviceAccountV1ResourceModel struct {
+	schema.ConsoleServiceAccountV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ServiceAccountV1Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,7 +55,7 @@ func (r *ServiceAccountV1Resource) Metadata(_ context.Context, req resource.Meta
 }
 
 func (r *ServiceAccountV1Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleServiceAccountV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleServiceAccountV1ResourceSchema(ctx))
 }
 
 func (r *ServiceAccountV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -66,17 +75,18 @@ func (r *ServiceAccountV1Resource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching Console version",
@@ -92,7 +102,8 @@ func (r *ServiceAccountV1Resource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
 }
 
 func (r *ServiceAccountV1Resource) ConfigValidators(_ctx context.Context) []resource.ConfigValidator {
@@ -104,8 +115,18 @@ func (r *ServiceAccountV1Resource) ConfigValidators(_ctx context.Context) []reso
 	}
 }
 
+func (r *ServiceAccountV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "service account",
+		func(ctx context.Context, data *serviceAccountV1ResourceModel) (console.ServiceAccountResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleServiceAccountV1Model)
+		},
+		func(res console.ServiceAccountResource) (string, error) {
+			return serviceAccountV1ApiPutPath(res.Metadata.Cluster), nil
+		})
+}
+
 func (r *ServiceAccountV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleServiceAccountV1Model
+	var data serviceAccountV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -114,10 +135,18 @@ func (r *ServiceAccountV1Resource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating service account named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create service account with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleServiceAccountV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
 		return
@@ -140,7 +169,7 @@ func (r *ServiceAccountV1Resource) Create(ctx context.Context, req resource.Crea
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleServiceAccountV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -151,7 +180,7 @@ func (r *ServiceAccountV1Resource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *ServiceAccountV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleServiceAccountV1Model
+	var data serviceAccountV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,6 +189,14 @@ func (r *ServiceAccountV1Resource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read service account named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, serviceAccountV1ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString()))
 	if err != nil {
@@ -181,7 +218,7 @@ func (r *ServiceAccountV1Resource) Read(ctx context.Context, req resource.ReadRe
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleServiceAccountV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -192,7 +229,7 @@ func (r *ServiceAccountV1Resource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ServiceAccountV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleServiceAccountV1Model
+	var data serviceAccountV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -201,10 +238,18 @@ func (r *ServiceAccountV1Resource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating service account named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update service account with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleServiceAccountV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
 		return
@@ -226,7 +271,7 @@ func (r *ServiceAccountV1Resource) Update(ctx context.Context, req resource.Upda
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleServiceAccountV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -236,7 +281,7 @@ func (r *ServiceAccountV1Resource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ServiceAccountV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleServiceAccountV1Model
+	var data serviceAccountV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -247,6 +292,14 @@ func (r *ServiceAccountV1Resource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := serviceAccountV1ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
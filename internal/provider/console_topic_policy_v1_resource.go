@@ -9,6 +9,7 @@ import (
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_topic_policy_v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ const topicPolicyEnterpriseOnlyVersion = "v1.43.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TopicPolicyV1Resource{}
 var _ resource.ResourceWithImportState = &TopicPolicyV1Resource{}
+var _ resource.ResourceWithModifyPlan = &TopicPolicyV1Resource{}
 
 func NewTopicPolicyV1Resource() resource.Resource {
 	return &TopicPolicyV1Resource{}
@@ -31,7 +33,14 @@ func NewTopicPolicyV1Resource() resource.Resource {
 
 // TopicPolicyV1Resource defines the resource implementation.
 type TopicPolicyV1Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// topicPolicyV1ResourceModel is the generated model along with the operation timeouts.
+type topicPolicyV1ResourceModel struct {
+	schema.ConsoleTopicPolicyV1Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TopicPolicyV1Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,7 +48,7 @@ func (r *TopicPolicyV1Resource) Metadata(_ context.Context, req resource.Metadat
 }
 
 func (r *TopicPolicyV1Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleTopicPolicyV1ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleTopicPolicyV1ResourceSchema(ctx))
 }
 
 func (r *TopicPolicyV1Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,17 +68,18 @@ func (r *TopicPolicyV1Resource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	consoleVersion, err := data.Client.GetAPIVersion(ctx, client.CONSOLE)
+	consoleVersion, err := apiClient.GetAPIVersion(ctx, client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Console version", err.Error())
 		return
@@ -82,16 +92,17 @@ func (r *TopicPolicyV1Resource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	checkEnterprisePlanRequirement(ctx, data.Client, consoleVersion, topicPolicyEnterpriseOnlyVersion, &resp.Diagnostics)
+	checkEnterprisePlanRequirement(ctx, apiClient, consoleVersion, topicPolicyEnterpriseOnlyVersion, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
 }
 
 func (r *TopicPolicyV1Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data schema.ConsoleTopicPolicyV1Model
+	var data topicPolicyV1ResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -129,8 +140,16 @@ func (r *TopicPolicyV1Resource) ValidateConfig(ctx context.Context, req resource
 	}
 }
 
+func (r *TopicPolicyV1Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "topic policy",
+		func(ctx context.Context, data *topicPolicyV1ResourceModel) (console.TopicPolicyResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleTopicPolicyV1Model)
+		},
+		staticPutPath[console.TopicPolicyResource](topicPolicyV1ApiPath))
+}
+
 func (r *TopicPolicyV1Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleTopicPolicyV1Model
+	var data topicPolicyV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -139,10 +158,18 @@ func (r *TopicPolicyV1Resource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating topic policy named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create topic policy with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleTopicPolicyV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create topic policy, got error: %s", err))
 		return
@@ -165,7 +192,7 @@ func (r *TopicPolicyV1Resource) Create(ctx context.Context, req resource.CreateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic policy, got error: %s", err))
 		return
@@ -176,7 +203,7 @@ func (r *TopicPolicyV1Resource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *TopicPolicyV1Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleTopicPolicyV1Model
+	var data topicPolicyV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -185,6 +212,14 @@ func (r *TopicPolicyV1Resource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read topic policy named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", topicPolicyV1ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -206,7 +241,7 @@ func (r *TopicPolicyV1Resource) Read(ctx context.Context, req resource.ReadReque
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic policy, got error: %s", err))
 		return
@@ -217,7 +252,7 @@ func (r *TopicPolicyV1Resource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *TopicPolicyV1Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleTopicPolicyV1Model
+	var data topicPolicyV1ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -226,10 +261,18 @@ func (r *TopicPolicyV1Resource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating topic policy named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update topic policy with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleTopicPolicyV1Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create topic policy, got error: %s", err))
 		return
@@ -251,7 +294,7 @@ func (r *TopicPolicyV1Resource) Update(ctx context.Context, req resource.UpdateR
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic policy state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicPolicyV1Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic policy, got error: %s", err))
 		return
@@ -261,7 +304,7 @@ func (r *TopicPolicyV1Resource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *TopicPolicyV1Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleTopicPolicyV1Model
+	var data topicPolicyV1ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -272,6 +315,14 @@ func (r *TopicPolicyV1Resource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", topicPolicyV1ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
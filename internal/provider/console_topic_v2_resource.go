@@ -9,6 +9,8 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_topic_v2"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_topic_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,6 +30,7 @@ const topicMininumVersion = "v1.30.0"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TopicV2Resource{}
 var _ resource.ResourceWithImportState = &TopicV2Resource{}
+var _ resource.ResourceWithModifyPlan = &TopicV2Resource{}
 
 func NewTopicV2Resource() resource.Resource {
 	return &TopicV2Resource{}
@@ -35,7 +38,16 @@ func NewTopicV2Resource() resource.Resource {
 
 // TopicV2Resource defines the resource implementation.
 type TopicV2Resource struct {
-	apiClient *client.Client
+	apiClient                *client.Client
+	validateOnPlan           bool
+	evaluateTopicPolicies    bool
+	evaluateResourcePolicies bool
+}
+
+// topicV2ResourceModel is the generated model along with the operation timeouts.
+type topicV2ResourceModel struct {
+	schema.ConsoleTopicV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TopicV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +55,7 @@ func (r *TopicV2Resource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *TopicV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleTopicV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleTopicV2ResourceSchema(ctx))
 }
 
 func (r *TopicV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -63,21 +75,47 @@ func (r *TopicV2Resource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+	r.evaluateResourcePolicies = data.EvaluateResourcePolicies
+	r.evaluateTopicPolicies = data.EvaluateTopicPolicies
+}
+
+func (r *TopicV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var checks []func(console.TopicConsoleResource) diag.Diagnostics
+	if r.evaluateTopicPolicies {
+		checks = append(checks, func(res console.TopicConsoleResource) diag.Diagnostics {
+			return evaluateTopicPolicies(ctx, r.apiClient, &res)
+		})
+	}
+	if r.evaluateResourcePolicies {
+		checks = append(checks, func(res console.TopicConsoleResource) diag.Diagnostics {
+			return evaluateResourcePolicies(ctx, r.apiClient, res, ownedBy(res.Metadata.Cluster, "TOPIC", res.Metadata.Name))
+		})
+	}
+
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "topic",
+		func(ctx context.Context, data *topicV2ResourceModel) (console.TopicConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleTopicV2Model)
+		},
+		func(res console.TopicConsoleResource) (string, error) {
+			return topicV2ApiPutPath(res.Metadata.Cluster), nil
+		}, checks...)
 }
 
 func (r *TopicV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleTopicV2Model
+	var data topicV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -86,10 +124,18 @@ func (r *TopicV2Resource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating topic named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create topic with desired state : %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleTopicV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create topic, got error: %s", err))
 		return
@@ -112,7 +158,7 @@ func (r *TopicV2Resource) Create(ctx context.Context, req resource.CreateRequest
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic, got error: %s", err))
 		return
@@ -123,7 +169,7 @@ func (r *TopicV2Resource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *TopicV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleTopicV2Model
+	var data topicV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -132,6 +178,14 @@ func (r *TopicV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read topic named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, topicV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString()))
 	if err != nil {
@@ -153,7 +207,7 @@ func (r *TopicV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic, got error: %s", err))
 		return
@@ -164,7 +218,7 @@ func (r *TopicV2Resource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *TopicV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleTopicV2Model
+	var data topicV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -173,10 +227,18 @@ func (r *TopicV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating topic named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update topic with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleTopicV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create topic, got error: %s", err))
 		return
@@ -198,7 +260,7 @@ func (r *TopicV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New topic state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic, got error: %s", err))
 		return
@@ -208,7 +270,7 @@ func (r *TopicV2Resource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *TopicV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleTopicV2Model
+	var data topicV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -219,6 +281,14 @@ func (r *TopicV2Resource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := topicV2ApiGetPath(data.Cluster.ValueString(), data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
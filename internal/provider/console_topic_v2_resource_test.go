@@ -97,6 +97,33 @@ func TestAccTopicV2Minimal(t *testing.T) {
 	})
 }
 
+func TestAccTopicV2Timeouts(t *testing.T) {
+	v, err := fetchClientVersion(client.CONSOLE)
+	if err != nil {
+		t.Fatalf("Error fetching current version: %s", err)
+	}
+
+	test.CheckMinimumVersionRequirement(t, v, topicMininumVersion)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read with operation timeouts
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/topic_v2/resource_with_timeouts.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_console_topic_v2.timeouts", "name", "topic-with-timeouts"),
+					resource.TestCheckResourceAttr("conduktor_console_topic_v2.timeouts", "timeouts.create", "2m"),
+					resource.TestCheckResourceAttr("conduktor_console_topic_v2.timeouts", "timeouts.read", "30s"),
+					resource.TestCheckResourceAttr("conduktor_console_topic_v2.timeouts", "timeouts.update", "2m"),
+					resource.TestCheckResourceAttr("conduktor_console_topic_v2.timeouts", "timeouts.delete", "1m"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccTopicV2Labels(t *testing.T) {
 	v, err := fetchClientVersion(client.CONSOLE)
 	if err != nil {
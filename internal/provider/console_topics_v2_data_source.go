@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/console_topic_v2"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_topic_v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TopicsV2DataSource{}
+var _ datasource.DataSourceWithConfigure = &TopicsV2DataSource{}
+
+func NewTopicsV2DataSource() datasource.DataSource {
+	return &TopicsV2DataSource{}
+}
+
+// TopicsV2DataSource defines the data source implementation.
+type TopicsV2DataSource struct {
+	apiClient *client.Client
+}
+
+// TopicsV2DataSourceModel describes the data source data model.
+type TopicsV2DataSourceModel struct {
+	Cluster   types.String                 `tfsdk:"cluster"`
+	NameRegex types.String                 `tfsdk:"name_regex"`
+	Labels    types.Map                    `tfsdk:"labels"`
+	Names     types.List                   `tfsdk:"names"`
+	Topics    []schema.ConsoleTopicV2Model `tfsdk:"topics"`
+}
+
+func (d *TopicsV2DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_topics_v2"
+}
+
+func (d *TopicsV2DataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Each listed topic has the same attributes as the resource, all read-only.
+	resourceSchema := schema.ConsoleTopicV2ResourceSchema(ctx)
+	topicAttributes, err := schemaUtils.DataSourceAttributesFromResource(resourceSchema.Attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("Unable to build topics data source schema, got error: %s", err))
+		return
+	}
+
+	resp.Schema = dschema.Schema{
+		Description:         "List topics of a Kafka cluster declared in Conduktor Console.",
+		MarkdownDescription: "List topics of a Kafka cluster declared in Conduktor Console.",
+		Attributes: map[string]dschema.Attribute{
+			"cluster": dschema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the Kafka cluster to list topics from",
+				MarkdownDescription: "Name of the Kafka cluster to list topics from",
+			},
+			"name_regex": dschema.StringAttribute{
+				Optional:            true,
+				Description:         "Regular expression (RE2 syntax) that topic names must match",
+				MarkdownDescription: "Regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)) that topic names must match",
+			},
+			"labels": dschema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Labels that topics must have, with the same values. Managed labels can also be used",
+				MarkdownDescription: "Labels that topics must have, with the same values. Managed labels can also be used",
+			},
+			"names": dschema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Names of the matching topics, sorted alphabetically",
+				MarkdownDescription: "Names of the matching topics, sorted alphabetically",
+			},
+			"topics": dschema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Matching topics, sorted alphabetically by name",
+				MarkdownDescription: "Matching topics, sorted alphabetically by name",
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: topicAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *TopicsV2DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this data source. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	d.apiClient = apiClient
+}
+
+func (d *TopicsV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TopicsV2DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if schemaUtils.AttrIsSet(data.NameRegex) {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", fmt.Sprintf("Unable to compile name_regex, got error: %s", err))
+			return
+		}
+	}
+
+	labels, diag := schemaUtils.MapValueToStringMap(ctx, data.Labels)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Topic list endpoint doesn't accept any filtering parameter, so all filters are applied on the client side.
+	tflog.Info(ctx, fmt.Sprintf("Listing topics of cluster %s", data.Cluster.String()))
+	get, err := d.apiClient.Describe(ctx, topicV2ApiPutPath(data.Cluster.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list topics, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cluster"),
+			"Kafka cluster not found",
+			fmt.Sprintf("No kafka cluster named %s found in Console", data.Cluster.String()),
+		)
+		return
+	}
+
+	var consoleRes []console.TopicConsoleResource
+	err = jsoniter.Unmarshal(get, &consoleRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing Error", fmt.Sprintf("Unable to list topics, got error: %s", err))
+		return
+	}
+
+	consoleRes = filterTopics(consoleRes, nameRegex, labels)
+	tflog.Debug(ctx, fmt.Sprintf("%d topics matching filters", len(consoleRes)))
+
+	names := make([]string, 0, len(consoleRes))
+	data.Topics = make([]schema.ConsoleTopicV2Model, 0, len(consoleRes))
+	for i := range consoleRes {
+		topic, err := mapper.InternalModelToTerraform(ctx, &consoleRes[i])
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read topic %s, got error: %s", consoleRes[i].Metadata.Name, err))
+			return
+		}
+		names = append(names, consoleRes[i].Metadata.Name)
+		data.Topics = append(data.Topics, topic)
+	}
+
+	data.Names, diag = schemaUtils.StringArrayToListValue(names)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterTopics keeps topics whose name matches nameRegex (if any) and that have all given labels, sorted by name.
+func filterTopics(topics []console.TopicConsoleResource, nameRegex *regexp.Regexp, labels map[string]string) []console.TopicConsoleResource {
+	result := make([]console.TopicConsoleResource, 0, len(topics))
+	for _, topic := range topics {
+		if nameRegex != nil && !nameRegex.MatchString(topic.Metadata.Name) {
+			continue
+		}
+		if !hasAllLabels(topic.Metadata.Labels, labels) {
+			continue
+		}
+		result = append(result, topic)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Metadata.Name < result[j].Metadata.Name
+	})
+	return result
+}
+
+func hasAllLabels(actual map[string]string, expected map[string]string) bool {
+	for key, value := range expected {
+		if v, ok := actual[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
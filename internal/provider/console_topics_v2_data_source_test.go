@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTopicsV2DataSource(t *testing.T) {
+	v, err := fetchClientVersion(client.CONSOLE)
+	if err != nil {
+		t.Fatalf("Error fetching current version: %s", err)
+	}
+	test.CheckMinimumVersionRequirement(t, v, topicMininumVersion)
+
+	allRef := "data.conduktor_console_topics_v2.all"
+	salesRef := "data.conduktor_console_topics_v2.sales"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "console/topics_v2/data_source.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allRef, "names.#", "2"),
+					resource.TestCheckResourceAttr(allRef, "names.0", "data-source-orders"),
+					resource.TestCheckResourceAttr(allRef, "names.1", "data-source-payments"),
+					resource.TestCheckResourceAttr(allRef, "topics.#", "2"),
+					resource.TestCheckResourceAttr(allRef, "topics.0.name", "data-source-orders"),
+					resource.TestCheckResourceAttr(allRef, "topics.0.cluster", "kafka-cluster"),
+					resource.TestCheckResourceAttr(allRef, "topics.0.labels.team", "sales"),
+					resource.TestCheckResourceAttr(allRef, "topics.0.spec.partitions", "1"),
+					resource.TestCheckResourceAttr(allRef, "topics.0.spec.replication_factor", "1"),
+					resource.TestCheckResourceAttr(allRef, "topics.1.name", "data-source-payments"),
+					resource.TestCheckResourceAttr(allRef, "topics.1.labels.team", "finance"),
+					resource.TestCheckResourceAttr(salesRef, "names.#", "1"),
+					resource.TestCheckResourceAttr(salesRef, "names.0", "data-source-orders"),
+					resource.TestCheckResourceAttr(salesRef, "topics.#", "1"),
+					resource.TestCheckResourceAttr(salesRef, "topics.0.name", "data-source-orders"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTopicsV2DataSourceInvalidRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "console/topics_v2/data_source_invalid_regex.tf"),
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+		},
+	})
+}
+
+func TestFilterTopics(t *testing.T) {
+	newTopic := func(name string, labels map[string]string) console.TopicConsoleResource {
+		return console.NewTopicConsoleResource(
+			console.TopicConsoleMetadata{Name: name, Cluster: "kafka-cluster", Labels: labels},
+			console.TopicConsoleSpec{Partitions: 1, ReplicationFactor: 1},
+		)
+	}
+	topics := []console.TopicConsoleResource{
+		newTopic("sales-orders", map[string]string{"team": "sales", "env": "prod"}),
+		newTopic("finance-payments", map[string]string{"team": "finance"}),
+		newTopic("sales-leads", map[string]string{"team": "sales", "env": "dev"}),
+		newTopic("unlabeled", nil),
+	}
+
+	names := func(topics []console.TopicConsoleResource) []string {
+		result := []string{}
+		for _, topic := range topics {
+			result = append(result, topic.Metadata.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex *regexp.Regexp
+		labels    map[string]string
+		expected  []string
+	}{
+		{
+			name:     "No filter",
+			expected: []string{"finance-payments", "sales-leads", "sales-orders", "unlabeled"},
+		},
+		{
+			name:      "Name regex",
+			nameRegex: regexp.MustCompile("^sales-"),
+			expected:  []string{"sales-leads", "sales-orders"},
+		},
+		{
+			name:     "Labels",
+			labels:   map[string]string{"team": "sales", "env": "prod"},
+			expected: []string{"sales-orders"},
+		},
+		{
+			name:      "Name regex and labels",
+			nameRegex: regexp.MustCompile("payments$"),
+			labels:    map[string]string{"team": "sales"},
+			expected:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, names(filterTopics(topics, tt.nameRegex, tt.labels)))
+		})
+	}
+}
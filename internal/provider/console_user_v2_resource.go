@@ -10,6 +10,7 @@ import (
 	"github.com/conduktor/terraform-provider-conduktor/internal/model"
 	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_user_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +21,7 @@ const userV2ApiPath = "/public/iam/v2/user"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserV2Resource{}
 var _ resource.ResourceWithImportState = &UserV2Resource{}
+var _ resource.ResourceWithModifyPlan = &UserV2Resource{}
 
 func NewUserV2Resource() resource.Resource {
 	return &UserV2Resource{}
@@ -27,7 +29,14 @@ func NewUserV2Resource() resource.Resource {
 
 // UserV2Resource defines the resource implementation.
 type UserV2Resource struct {
-	apiClient *client.Client
+	apiClient      *client.Client
+	validateOnPlan bool
+}
+
+// userV2ResourceModel is the generated model along with the operation timeouts.
+type userV2ResourceModel struct {
+	schema.ConsoleUserV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *UserV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +44,7 @@ func (r *UserV2Resource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *UserV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.ConsoleUserV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.ConsoleUserV2ResourceSchema(ctx))
 }
 
 func (r *UserV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,21 +64,31 @@ func (r *UserV2Resource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	apiClient := data.ClientFor(client.CONSOLE)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode or `console` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
+	r.validateOnPlan = data.ValidateOnPlan
+}
+
+func (r *UserV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedResource(ctx, req, resp, r.apiClient, r.validateOnPlan, "user",
+		func(ctx context.Context, data *userV2ResourceModel) (console.UserConsoleResource, error) {
+			return mapper.TFToInternalModel(ctx, &data.ConsoleUserV2Model)
+		},
+		staticPutPath[console.UserConsoleResource](userV2ApiPath))
 }
 
 func (r *UserV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.ConsoleUserV2Model
+	var data userV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -77,10 +96,19 @@ func (r *UserV2Resource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Create user named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create user with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleUserV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create user, got error: %s", err))
 		return
@@ -106,7 +134,7 @@ func (r *UserV2Resource) Create(ctx context.Context, req resource.CreateRequest,
 	consoleRes.Spec.Permissions = model.MergeWithPlannedPermissions(consoleResource.Spec.Permissions, consoleRes.Spec.Permissions)
 	tflog.Debug(ctx, fmt.Sprintf("New user state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleUserV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read user, got error: %s", err))
 		return
@@ -117,7 +145,7 @@ func (r *UserV2Resource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *UserV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.ConsoleUserV2Model
+	var data userV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -126,6 +154,14 @@ func (r *UserV2Resource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read user named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", userV2ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -147,7 +183,7 @@ func (r *UserV2Resource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New user state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleUserV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read user, got error: %s", err))
 		return
@@ -158,7 +194,7 @@ func (r *UserV2Resource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *UserV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.ConsoleUserV2Model
+	var data userV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -167,10 +203,18 @@ func (r *UserV2Resource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Update user named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update user with TF data: %+v", data))
 
-	consoleResource, err := mapper.TFToInternalModel(ctx, &data)
+	consoleResource, err := mapper.TFToInternalModel(ctx, &data.ConsoleUserV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create user, got error: %s", err))
 		return
@@ -195,7 +239,7 @@ func (r *UserV2Resource) Update(ctx context.Context, req resource.UpdateRequest,
 	consoleRes.Spec.Permissions = model.MergeWithPlannedPermissions(consoleResource.Spec.Permissions, consoleRes.Spec.Permissions)
 	tflog.Debug(ctx, fmt.Sprintf("New user state : %+v", consoleRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
+	data.ConsoleUserV2Model, err = mapper.InternalModelToTerraform(ctx, &consoleRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read user, got error: %s", err))
 		return
@@ -206,7 +250,7 @@ func (r *UserV2Resource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *UserV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.ConsoleUserV2Model
+	var data userV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -216,6 +260,15 @@ func (r *UserV2Resource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", userV2ApiPath, data.Name.ValueString())
 	err := r.apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
 	if err != nil {
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/resourcepolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EvaluateResourcePolicyFunction{}
+
+func NewEvaluateResourcePolicyFunction() function.Function {
+	return &EvaluateResourcePolicyFunction{}
+}
+
+// EvaluateResourcePolicyFunction defines the function implementation.
+type EvaluateResourcePolicyFunction struct{}
+
+// resourcePolicyRuleArgument is a rule of the policy argument, matching the rules of a resource policy spec.
+type resourcePolicyRuleArgument struct {
+	Condition    string `tfsdk:"condition"`
+	ErrorMessage string `tfsdk:"error_message"`
+}
+
+// resourcePolicyArgument is the policy argument, matching the spec of a resource policy without its description.
+type resourcePolicyArgument struct {
+	TargetKind string                       `tfsdk:"target_kind"`
+	Rules      []resourcePolicyRuleArgument `tfsdk:"rules"`
+}
+
+func (f *EvaluateResourcePolicyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_resource_policy"
+}
+
+func (f *EvaluateResourcePolicyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate the rules of a resource policy against a resource manifest",
+		MarkdownDescription: "Evaluates the CEL conditions of the rules of a resource policy against a resource manifest, the same way Console does on apply, " +
+			"and returns the error messages of the rules that are not satisfied. An empty list means the manifest complies with the policy.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "policy",
+				MarkdownDescription: "Spec of the resource policy, with `target_kind` and `rules`, like the `spec` of a `conduktor_console_resource_policy_v1` resource.",
+				AttributeTypes: map[string]attr.Type{
+					"target_kind": types.StringType,
+					"rules": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+						"condition":     types.StringType,
+						"error_message": types.StringType,
+					}}},
+				},
+			},
+			function.DynamicParameter{
+				Name:                "manifest",
+				MarkdownDescription: "Resource manifest as sent to Console, with `kind`, `metadata` and `spec`. The `kind`, if set, must match the policy `target_kind`.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *EvaluateResourcePolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policyArgument resourcePolicyArgument
+	var manifestArgument types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policyArgument, &manifestArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	manifestValue, err := manifestArgument.ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to read manifest, got error: %s", err))
+		return
+	}
+	native, err := tftypesToNative(manifestValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to read manifest, got error: %s", err))
+		return
+	}
+	manifest, ok := native.(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, "Manifest must be an object")
+		return
+	}
+
+	policy := console.NewResourcePolicyConsoleResource(
+		console.ResourcePolicyConsoleMetadata{Name: "evaluated"},
+		console.ResourcePolicyConsoleSpec{TargetKind: policyArgument.TargetKind},
+	)
+	for _, rule := range policyArgument.Rules {
+		policy.Spec.Rules = append(policy.Spec.Rules, console.ResourcePolicyConsoleRule{Condition: rule.Condition, ErrorMessage: rule.ErrorMessage})
+	}
+
+	violations, err := resourcepolicy.Evaluate(&policy, manifest)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to evaluate resource policy, got error: %s", err))
+		return
+	}
+
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.Message)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, messages))
+}
+
+// tftypesToNative converts a known Terraform value to the Go values CEL expects: maps, slices, strings, booleans,
+// and numbers as integers when whole.
+func tftypesToNative(value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("value is not known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		if i, accuracy := n.Int64(); accuracy == big.Exact {
+			return i, nil
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make([]any, 0, len(elements))
+		for _, element := range elements {
+			native, err := tftypesToNative(element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, native)
+		}
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		result := make(map[string]any, len(attributes))
+		for key, attribute := range attributes {
+			native, err := tftypesToNative(attribute)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = native
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", typ)
+}
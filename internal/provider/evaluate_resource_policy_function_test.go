@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func resourcePolicyArgumentValue(targetKind string, rules map[string]string) attr.Value {
+	ruleType := types.ObjectType{AttrTypes: map[string]attr.Type{"condition": types.StringType, "error_message": types.StringType}}
+	var ruleValues []attr.Value
+	for condition, message := range rules {
+		ruleValues = append(ruleValues, types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"condition":     types.StringValue(condition),
+			"error_message": types.StringValue(message),
+		}))
+	}
+	return types.ObjectValueMust(
+		map[string]attr.Type{"target_kind": types.StringType, "rules": types.ListType{ElemType: ruleType}},
+		map[string]attr.Value{"target_kind": types.StringValue(targetKind), "rules": types.ListValueMust(ruleType, ruleValues)},
+	)
+}
+
+func topicManifestValue(retention string) attr.Value {
+	return types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"kind":     types.StringType,
+			"metadata": types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}},
+			"spec": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"partitions": types.NumberType,
+				"configs":    types.MapType{ElemType: types.StringType},
+			}},
+		},
+		map[string]attr.Value{
+			"kind": types.StringValue("Topic"),
+			"metadata": types.ObjectValueMust(map[string]attr.Type{"name": types.StringType}, map[string]attr.Value{
+				"name": types.StringValue("click.events.avro"),
+			}),
+			"spec": types.ObjectValueMust(
+				map[string]attr.Type{"partitions": types.NumberType, "configs": types.MapType{ElemType: types.StringType}},
+				map[string]attr.Value{
+					"partitions": types.NumberValue(big.NewFloat(3)),
+					"configs":    types.MapValueMust(types.StringType, map[string]attr.Value{"retention.ms": types.StringValue(retention)}),
+				},
+			),
+		},
+	))
+}
+
+func TestEvaluateResourcePolicyFunction(t *testing.T) {
+	policy := resourcePolicyArgumentValue("Topic", map[string]string{
+		`metadata.name.matches("^click\\.[a-z0-9-]+\\.(avro|json)$")`: "topic name should start with click",
+		`spec.partitions == 3`:                                 "topics must have 3 partitions",
+		`int(string(spec.configs["retention.ms"])) <= 3600000`: "retention should be at most 1h",
+	})
+	emptyList := types.ListUnknown(types.StringType)
+
+	result, err := runFunction(t, NewEvaluateResourcePolicyFunction(), emptyList, policy, topicManifestValue("60000"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{}), result)
+
+	result, err = runFunction(t, NewEvaluateResourcePolicyFunction(), emptyList, policy, topicManifestValue("86400000"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("retention should be at most 1h")}), result)
+
+	_, err = runFunction(t, NewEvaluateResourcePolicyFunction(), emptyList, resourcePolicyArgumentValue("Connector", nil), topicManifestValue("60000"))
+	assert.ErrorContains(t, err, "resource policy evaluated targets kind Connector, got Topic")
+
+	_, err = runFunction(t, NewEvaluateResourcePolicyFunction(), emptyList, resourcePolicyArgumentValue("Topic", map[string]string{"spec.partitions >": "invalid"}), topicManifestValue("60000"))
+	assert.ErrorContains(t, err, "Unable to evaluate resource policy")
+}
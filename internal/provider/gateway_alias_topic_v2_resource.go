@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_alias_topic_v2"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_alias_topic_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const gatewayAliasTopicV2ApiPath = "/gateway/v2/alias-topic"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayAliasTopicV2Resource{}
+var _ resource.ResourceWithImportState = &GatewayAliasTopicV2Resource{}
+var _ resource.ResourceWithModifyPlan = &GatewayAliasTopicV2Resource{}
+
+func NewGatewayAliasTopicV2Resource() resource.Resource {
+	return &GatewayAliasTopicV2Resource{}
+}
+
+// GatewayAliasTopicV2Resource defines the resource implementation.
+type GatewayAliasTopicV2Resource struct {
+	apiClient *client.Client
+	// consoleClient is used to check the physical topic exists, nil if the provider is not configured for Console.
+	consoleClient *client.Client
+}
+
+// gatewayAliasTopicV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayAliasTopicV2ResourceModel struct {
+	schema.GatewayAliasTopicV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GatewayAliasTopicV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_alias_topic_v2"
+}
+
+func (r *GatewayAliasTopicV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, schema.GatewayAliasTopicV2ResourceSchema(ctx))
+}
+
+func (r *GatewayAliasTopicV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+	r.consoleClient = data.ClientFor(client.CONSOLE)
+}
+
+func (r *GatewayAliasTopicV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data gatewayAliasTopicV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPhysicalTopic(ctx, r.consoleClient, data.PhysicalCluster, data.Spec.PhysicalName)...)
+}
+
+func (r *GatewayAliasTopicV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data gatewayAliasTopicV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Create alias topic named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Create alias topic with TF data: %+v", data))
+
+	gatewayResource := mapper.TFToInternalModel(&data.GatewayAliasTopicV2Model)
+	tflog.Debug(ctx, fmt.Sprintf("Alias topic to create : %+v", gatewayResource))
+
+	physicalCluster := data.PhysicalCluster
+	apply, err := r.apiClient.Apply(ctx, gatewayAliasTopicV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alias topic, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Alias topic created with result: %s", apply))
+
+	var gatewayRes gateway.GatewayAliasTopicResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as alias topic : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New alias topic state : %+v", gatewayRes))
+
+	data.GatewayAliasTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alias topic, got error: %s", err))
+		return
+	}
+	data.PhysicalCluster = physicalCluster
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayAliasTopicV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data gatewayAliasTopicV2ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Only appending vcluster if present
+	queryString := "name=" + data.Name.ValueString()
+	if data.Vcluster.ValueString() != "" {
+		queryString += "&vcluster=" + data.Vcluster.ValueString()
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read alias topic named %s on vcluster %s", data.Name.String(), data.Vcluster.String()))
+	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s?%s", gatewayAliasTopicV2ApiPath, queryString))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alias topic, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Alias topic %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var gatewayResult = []gateway.GatewayAliasTopicResource{}
+	err = json.Unmarshal(get, &gatewayResult)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alias topic, got error: %s", err))
+		return
+	}
+	if len(gatewayResult) > 1 {
+		tflog.Warn(ctx, fmt.Sprintf("Multiple alias topics found with query name %s and vcluster %s, using best match", data.Name.String(), data.Vcluster.ValueString()))
+	}
+	var gatewayResource = gateway.GatewayAliasTopicResource{}
+	var matchFound = false
+	for _, res := range gatewayResult {
+		nameMatch := res.Metadata.Name == data.Name.ValueString()
+		vclusterMatch := res.Metadata.VCluster == data.Vcluster.ValueString()
+		passthroughMatch := res.Metadata.VCluster == "" && data.Vcluster.ValueString() == "passthrough"
+
+		if nameMatch && (vclusterMatch || passthroughMatch) {
+			gatewayResource = res
+			matchFound = true
+			break
+		}
+	}
+	if !matchFound {
+		tflog.Debug(ctx, fmt.Sprintf("Alias topic %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("New alias topic state : %+v", gatewayResource))
+
+	physicalCluster := data.PhysicalCluster
+	data.GatewayAliasTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alias topic, got error: %s", err))
+		return
+	}
+	data.PhysicalCluster = physicalCluster
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayAliasTopicV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data gatewayAliasTopicV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Update alias topic named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update alias topic with TF data: %+v", data))
+
+	gatewayResource := mapper.TFToInternalModel(&data.GatewayAliasTopicV2Model)
+	tflog.Debug(ctx, fmt.Sprintf("Alias topic to update : %+v", gatewayResource))
+
+	physicalCluster := data.PhysicalCluster
+	apply, err := r.apiClient.Apply(ctx, gatewayAliasTopicV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alias topic, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Alias topic updated with result: %s", apply))
+
+	var gatewayRes gateway.GatewayAliasTopicResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as alias topic : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New alias topic state : %+v", gatewayRes))
+
+	data.GatewayAliasTopicV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read alias topic, got error: %s", err))
+		return
+	}
+	data.PhysicalCluster = physicalCluster
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayAliasTopicV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data gatewayAliasTopicV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Delete alias topic named %s", data.Name.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	deleteRes := gateway.GatewayAliasTopicMetadata{
+		Name:     data.Name.ValueString(),
+		VCluster: data.Vcluster.ValueString(),
+	}
+
+	err := r.apiClient.Delete(ctx, client.GATEWAY, gatewayAliasTopicV2ApiPath, deleteRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alias topic, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Alias topic %s deleted", data.Name.String()))
+}
+
+// ImportState imports the state of the resource from the given ID.
+// The ID is expected to be in the format: <alias_topic_name>/<vcluster>, or <alias_topic_name> only for an alias topic of the passthrough vcluster.
+func (r *GatewayAliasTopicV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) > 2 || idParts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <alias_topic_name>/<vcluster>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+
+	// optional vcluster part, defaulting to passthrough
+	if len(idParts) == 2 && idParts[1] != "" && idParts[1] != "null" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vcluster"), idParts[1])...)
+	}
+}
+
+// checkPhysicalTopic warns if the physical topic of an alias topic doesn't exist on the Console cluster backing the Gateway.
+// The check is skipped if the cluster is not set, or if the values are not known yet.
+func checkPhysicalTopic(ctx context.Context, consoleClient *client.Client, physicalCluster, physicalName types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if physicalCluster.IsNull() || physicalCluster.IsUnknown() || physicalName.IsNull() || physicalName.IsUnknown() {
+		return diags
+	}
+	if consoleClient == nil {
+		diags.AddAttributeWarning(path.Root("physical_cluster"), "Physical topic not checked",
+			"physical_cluster is set but the provider is not configured for Console, the existence of the physical topic can't be checked.")
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Checking physical topic %s exists on cluster %s", physicalName.String(), physicalCluster.String()))
+	get, err := consoleClient.Describe(ctx, topicV2ApiGetPath(physicalCluster.ValueString(), physicalName.ValueString()))
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("spec").AtName("physical_name"), "Physical topic not checked",
+			fmt.Sprintf("Unable to read physical topic %s on cluster %s, got error: %s", physicalName.String(), physicalCluster.String(), err))
+		return diags
+	}
+	if len(get) == 0 {
+		diags.AddAttributeWarning(path.Root("spec").AtName("physical_name"), "Physical topic not found",
+			fmt.Sprintf("Physical topic %s doesn't exist on cluster %s, clients of the alias topic won't be able to use it until it is created.", physicalName.String(), physicalCluster.String()))
+	}
+	return diags
+}
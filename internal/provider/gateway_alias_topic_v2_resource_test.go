@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPhysicalTopic(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api"+topicV2ApiGetPath("kafka-cluster", "existing-topic") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Topic","metadata":{"name":"existing-topic"}}`))
+	}))
+	t.Cleanup(ts.Close)
+	consoleClient, err := client.Make(context.Background(), client.CONSOLE, client.ApiParameter{BaseUrl: ts.URL, ApiKey: "test-key"}, "test")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		consoleClient   *client.Client
+		physicalCluster types.String
+		physicalName    types.String
+		warning         string
+	}{
+		{name: "existing topic", consoleClient: consoleClient, physicalCluster: types.StringValue("kafka-cluster"), physicalName: types.StringValue("existing-topic")},
+		{name: "missing topic", consoleClient: consoleClient, physicalCluster: types.StringValue("kafka-cluster"), physicalName: types.StringValue("missing-topic"), warning: "Physical topic not found"},
+		{name: "no physical cluster", consoleClient: consoleClient, physicalCluster: types.StringNull(), physicalName: types.StringValue("missing-topic")},
+		{name: "unknown physical name", consoleClient: consoleClient, physicalCluster: types.StringValue("kafka-cluster"), physicalName: types.StringUnknown()},
+		{name: "no console client", physicalCluster: types.StringValue("kafka-cluster"), physicalName: types.StringValue("existing-topic"), warning: "Physical topic not checked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkPhysicalTopic(context.Background(), tt.consoleClient, tt.physicalCluster, tt.physicalName)
+			assert.False(t, diags.HasError())
+			if tt.warning == "" {
+				assert.Empty(t, diags)
+			} else {
+				require.Len(t, diags, 1)
+				assert.Equal(t, tt.warning, diags[0].Summary())
+			}
+		})
+	}
+}
+
+func TestGatewayAliasTopicV2ImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &GatewayAliasTopicV2Resource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		id       string
+		name     string
+		vcluster types.String
+		invalid  bool
+	}{
+		{id: "alias", name: "alias", vcluster: types.StringNull()},
+		{id: "alias/vcluster1", name: "alias", vcluster: types.StringValue("vcluster1")},
+		{id: "/vcluster1", invalid: true},
+		{id: "alias/vcluster1/extra", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			resp := &fwresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tt.id}, resp)
+			if tt.invalid {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var name, vcluster types.String
+			resp.State.GetAttribute(ctx, path.Root("name"), &name)
+			resp.State.GetAttribute(ctx, path.Root("vcluster"), &vcluster)
+			assert.Equal(t, tt.name, name.ValueString())
+			assert.Equal(t, tt.vcluster, vcluster)
+		})
+	}
+}
+
+func TestAccGatewayAliasTopicV2Resource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resourceRef := "conduktor_gateway_alias_topic_v2.test"
+
+	gwClient, err := testClient(client.GATEWAY)
+	if err != nil {
+		t.Fatalf("Error creating gateway client: %s", err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/alias_topic_v2/resource_create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-alias"),
+					resource.TestCheckResourceAttr(resourceRef, "vcluster", "vcluster_alias"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_name", "test-physical-topic"),
+				),
+			},
+			// Importing matches the state of the previous step.
+			{
+				ResourceName:                         resourceRef,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "test-alias/vcluster_alias",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Test plan changes if externally deleted resource
+			{
+				PreConfig: func() {
+					// wait a bit to ensure the alias topic is created
+					time.Sleep(1 * time.Second)
+					deleteRes := gateway.GatewayAliasTopicMetadata{
+						Name:     "test-alias",
+						VCluster: "vcluster_alias",
+					}
+					t.Logf("Deleting alias topic %s in vcluster %s", deleteRes.Name, deleteRes.VCluster)
+					err := gwClient.Delete(context.Background(), client.GATEWAY, gatewayAliasTopicV2ApiPath, deleteRes)
+					if err != nil {
+						t.Fatalf("Error externally deleting alias topic: %s", err)
+					}
+				},
+				Config:             providerConfigGateway + test.TestAccTestdata(t, "gateway/alias_topic_v2/resource_create.tf"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+					},
+				},
+			},
+			// Re-create and Read testing for update test
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/alias_topic_v2/resource_create.tf"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/alias_topic_v2/resource_update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-alias"),
+					resource.TestCheckResourceAttr(resourceRef, "vcluster", "vcluster_alias"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_name", "test-physical-topic-v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGatewayAliasTopicV2Minimal(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read from minimal example
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/alias_topic_v2/resource_minimal.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.minimal", "name", "minimal-alias"),
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.minimal", "vcluster", "passthrough"),
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.minimal", "spec.physical_name", "minimal-physical-topic"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGatewayAliasTopicV2ExampleResource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read from simple example
+			{
+				Config: providerConfigGateway + test.TestAccExample(t, "resources", "conduktor_gateway_alias_topic_v2", "simple.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.simple", "name", "orders"),
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.simple", "vcluster", "passthrough"),
+					resource.TestCheckResourceAttr("conduktor_gateway_alias_topic_v2.simple", "spec.physical_name", "prod.orders.v2"),
+				),
+			},
+		},
+	})
+}
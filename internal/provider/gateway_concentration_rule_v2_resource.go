@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_concentration_rule_v2"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_concentration_rule_v2"
+	"github.com/conduktor/terraform-provider-conduktor/internal/topicpattern"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const gatewayConcentrationRuleV2ApiPath = "/gateway/v2/concentration-rule"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayConcentrationRuleV2Resource{}
+var _ resource.ResourceWithImportState = &GatewayConcentrationRuleV2Resource{}
+var _ resource.ResourceWithModifyPlan = &GatewayConcentrationRuleV2Resource{}
+
+func NewGatewayConcentrationRuleV2Resource() resource.Resource {
+	return &GatewayConcentrationRuleV2Resource{}
+}
+
+// GatewayConcentrationRuleV2Resource defines the resource implementation.
+type GatewayConcentrationRuleV2Resource struct {
+	apiClient *client.Client
+}
+
+// gatewayConcentrationRuleV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayConcentrationRuleV2ResourceModel struct {
+	schema.GatewayConcentrationRuleV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_concentration_rule_v2"
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, schema.GatewayConcentrationRuleV2ResourceSchema(ctx))
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+}
+
+func (r *GatewayConcentrationRuleV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !planToValidate(ctx, true, req) {
+		return
+	}
+
+	var data gatewayConcentrationRuleV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkOverlappingPatterns(ctx, &data)...)
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data gatewayConcentrationRuleV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Create concentration rule named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Create concentration rule with TF data: %+v", data))
+
+	// Rules created in the same apply weren't on Gateway at plan time, so the patterns are compared again before applying.
+	resp.Diagnostics.Append(r.checkOverlappingPatterns(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayConcentrationRuleV2Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create concentration rule, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Concentration rule to create : %+v", gatewayResource))
+
+	apply, err := r.apiClient.Apply(ctx, gatewayConcentrationRuleV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create concentration rule, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Concentration rule created with result: %s", apply))
+
+	var gatewayRes gateway.GatewayConcentrationRuleResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as concentration rule : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New concentration rule state : %+v", gatewayRes))
+
+	data.GatewayConcentrationRuleV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read concentration rule, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data gatewayConcentrationRuleV2ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Only appending vcluster if present
+	queryString := "name=" + data.Name.ValueString()
+	if data.Vcluster.ValueString() != "" {
+		queryString += "&vcluster=" + data.Vcluster.ValueString()
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read concentration rule named %s on vcluster %s", data.Name.String(), data.Vcluster.String()))
+	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s?%s", gatewayConcentrationRuleV2ApiPath, queryString))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read concentration rule, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Concentration rule %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var gatewayResult = []gateway.GatewayConcentrationRuleResource{}
+	err = json.Unmarshal(get, &gatewayResult)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read concentration rule, got error: %s", err))
+		return
+	}
+	if len(gatewayResult) > 1 {
+		tflog.Warn(ctx, fmt.Sprintf("Multiple concentration rules found with query name %s and vcluster %s, using best match", data.Name.String(), data.Vcluster.ValueString()))
+	}
+	var gatewayResource = gateway.GatewayConcentrationRuleResource{}
+	var matchFound = false
+	for _, res := range gatewayResult {
+		nameMatch := res.Metadata.Name == data.Name.ValueString()
+		vclusterMatch := res.Metadata.VCluster == data.Vcluster.ValueString()
+		passthroughMatch := res.Metadata.VCluster == "" && data.Vcluster.ValueString() == "passthrough"
+
+		if nameMatch && (vclusterMatch || passthroughMatch) {
+			gatewayResource = res
+			matchFound = true
+			break
+		}
+	}
+	if !matchFound {
+		tflog.Debug(ctx, fmt.Sprintf("Concentration rule %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("New concentration rule state : %+v", gatewayResource))
+
+	data.GatewayConcentrationRuleV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read concentration rule, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data gatewayConcentrationRuleV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Update concentration rule named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update concentration rule with TF data: %+v", data))
+
+	resp.Diagnostics.Append(r.checkOverlappingPatterns(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayConcentrationRuleV2Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to update concentration rule, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Concentration rule to update : %+v", gatewayResource))
+
+	apply, err := r.apiClient.Apply(ctx, gatewayConcentrationRuleV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update concentration rule, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Concentration rule updated with result: %s", apply))
+
+	var gatewayRes gateway.GatewayConcentrationRuleResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as concentration rule : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New concentration rule state : %+v", gatewayRes))
+
+	data.GatewayConcentrationRuleV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read concentration rule, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayConcentrationRuleV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data gatewayConcentrationRuleV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Delete concentration rule named %s", data.Name.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	deleteRes := gateway.GatewayConcentrationRuleMetadata{
+		Name:     data.Name.ValueString(),
+		VCluster: data.Vcluster.ValueString(),
+	}
+
+	err := r.apiClient.Delete(ctx, client.GATEWAY, gatewayConcentrationRuleV2ApiPath, deleteRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete concentration rule, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Concentration rule %s deleted", data.Name.String()))
+}
+
+// ImportState imports the state of the resource from the given ID.
+// The ID is expected to be in the format: <vcluster>/<concentration_rule_name>, or <concentration_rule_name> only for a concentration rule of the passthrough vcluster.
+func (r *GatewayConcentrationRuleV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) > 2 || idParts[len(idParts)-1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <vcluster>/<concentration_rule_name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[len(idParts)-1])...)
+
+	// optional vcluster part, defaulting to passthrough
+	if len(idParts) == 2 && idParts[0] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vcluster"), idParts[0])...)
+	}
+}
+
+// checkOverlappingPatterns rejects a concentration rule whose pattern overlaps the pattern of another
+// concentration rule of the same vcluster, as Gateway couldn't tell which rule concentrates the matching topics.
+// Patterns that can't be compared locally are left to Gateway.
+func (r *GatewayConcentrationRuleV2Resource) checkOverlappingPatterns(ctx context.Context, data *gatewayConcentrationRuleV2ResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vcluster := data.Vcluster.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Listing concentration rules of vcluster %s to check overlapping patterns", vcluster))
+	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s?vcluster=%s", gatewayConcentrationRuleV2ApiPath, vcluster))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list concentration rules, got error: %s", err))
+		return diags
+	}
+	if len(get) == 0 {
+		return diags
+	}
+
+	var rules []gateway.GatewayConcentrationRuleResource
+	err = json.Unmarshal(get, &rules)
+	if err != nil {
+		diags.AddError("Parsing Error", fmt.Sprintf("Unable to list concentration rules, got error: %s", err))
+		return diags
+	}
+
+	for _, rule := range overlappingRules(ctx, data.Name.ValueString(), vcluster, data.Spec.Pattern.ValueString(), rules) {
+		diags.AddAttributeError(path.Root("spec").AtName("pattern"), "Overlapping concentration rule pattern",
+			fmt.Sprintf("Pattern %q overlaps pattern %q of concentration rule %s of vcluster %s, both matching topic %q for instance.",
+				data.Spec.Pattern.ValueString(), rule.pattern, rule.name, vcluster, rule.example))
+	}
+	return diags
+}
+
+// overlappingRule is a concentration rule whose pattern overlaps a planned one.
+type overlappingRule struct {
+	name    string
+	pattern string
+	// Topic name matched by both patterns.
+	example string
+}
+
+// overlappingRules returns the concentration rules of the vcluster, other than the named one, whose pattern overlaps the given one.
+func overlappingRules(ctx context.Context, name, vcluster, pattern string, rules []gateway.GatewayConcentrationRuleResource) []overlappingRule {
+	var overlapping []overlappingRule
+	for _, rule := range rules {
+		ruleVCluster := rule.Metadata.VCluster
+		if ruleVCluster == "" {
+			ruleVCluster = "passthrough"
+		}
+		if rule.Metadata.Name == name || ruleVCluster != vcluster {
+			continue
+		}
+
+		overlap, example, err := topicpattern.Overlap(pattern, rule.Spec.Pattern)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to compare pattern with concentration rule %s, got error: %s", rule.Metadata.Name, err))
+			continue
+		}
+		if overlap {
+			overlapping = append(overlapping, overlappingRule{name: rule.Metadata.Name, pattern: rule.Spec.Pattern, example: example})
+		}
+	}
+	return overlapping
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlappingRules(t *testing.T) {
+	newRule := func(name, vcluster, pattern string) gateway.GatewayConcentrationRuleResource {
+		return gateway.NewGatewayConcentrationRuleResource(
+			gateway.GatewayConcentrationRuleMetadata{Name: name, VCluster: vcluster},
+			gateway.GatewayConcentrationRuleSpec{Pattern: pattern, PhysicalTopics: gateway.GatewayConcentrationRulePhysicalTopics{Delete: name}},
+		)
+	}
+	rules := []gateway.GatewayConcentrationRuleResource{
+		newRule("planned", "passthrough", "orders-.*"),
+		newRule("orders-eu", "", "orders-eu-.*"),
+		newRule("payments", "passthrough", "payments-.*"),
+		newRule("other-vcluster", "vcluster1", "orders-.*"),
+		newRule("invalid", "passthrough", "orders-("),
+	}
+
+	overlapping := overlappingRules(context.Background(), "planned", "passthrough", "orders-.*", rules)
+	assert.Equal(t, []overlappingRule{{name: "orders-eu", pattern: "orders-eu-.*", example: "orders-eu-"}}, overlapping)
+
+	assert.Empty(t, overlappingRules(context.Background(), "planned", "vcluster2", "orders-.*", rules))
+}
+
+func TestAccGatewayConcentrationRuleV2Resource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resourceRef := "conduktor_gateway_concentration_rule_v2.test"
+
+	gwClient, err := testClient(client.GATEWAY)
+	if err != nil {
+		t.Fatalf("Error creating gateway client: %s", err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-concentration-rule"),
+					resource.TestCheckResourceAttr(resourceRef, "vcluster", "vcluster_concentration"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.pattern", "test-concentrated-.*"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_topics.delete", "test-concentration-delete"),
+					resource.TestCheckNoResourceAttr(resourceRef, "spec.physical_topics.compact"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.auto_managed", "false"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.offset_correctness", "false"),
+				),
+			},
+			// Importing matches the state of the previous step.
+			{
+				ResourceName:                         resourceRef,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "vcluster_concentration/test-concentration-rule",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Overlapping pattern in the same vcluster is rejected on plan
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_create.tf") +
+					test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_overlapping.tf"),
+				ExpectError: regexp.MustCompile("Overlapping concentration rule pattern"),
+			},
+			// Test plan changes if externally deleted resource
+			{
+				PreConfig: func() {
+					// wait a bit to ensure the concentration rule is created
+					time.Sleep(1 * time.Second)
+					deleteRes := gateway.GatewayConcentrationRuleMetadata{
+						Name:     "test-concentration-rule",
+						VCluster: "vcluster_concentration",
+					}
+					t.Logf("Deleting concentration rule %s in vcluster %s", deleteRes.Name, deleteRes.VCluster)
+					err := gwClient.Delete(context.Background(), client.GATEWAY, gatewayConcentrationRuleV2ApiPath, deleteRes)
+					if err != nil {
+						t.Fatalf("Error externally deleting concentration rule: %s", err)
+					}
+				},
+				Config:             providerConfigGateway + test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_create.tf"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+					},
+				},
+			},
+			// Re-create and Read testing for update test
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_create.tf"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/concentration_rule_v2/resource_update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-concentration-rule"),
+					resource.TestCheckResourceAttr(resourceRef, "vcluster", "vcluster_concentration"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.pattern", "test-concentrated-.*"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_topics.delete", "test-concentration-delete"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_topics.compact", "test-concentration-compact"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.physical_topics.delete_compact", "test-concentration-delete-compact"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.auto_managed", "true"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.offset_correctness", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGatewayConcentrationRuleV2ExampleResource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read from simple example
+			{
+				Config: providerConfigGateway + test.TestAccExample(t, "resources", "conduktor_gateway_concentration_rule_v2", "simple.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.simple", "name", "simple-concentration-rule"),
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.simple", "vcluster", "passthrough"),
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.simple", "spec.physical_topics.delete", "concentrated-events"),
+				),
+			},
+			// Create and Read from complex example
+			{
+				Config: providerConfigGateway + test.TestAccExample(t, "resources", "conduktor_gateway_concentration_rule_v2", "complex.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.complex", "name", "complex-concentration-rule"),
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.complex", "vcluster", "vcluster_sa"),
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.complex", "spec.auto_managed", "true"),
+					resource.TestCheckResourceAttr("conduktor_gateway_concentration_rule_v2.complex", "spec.offset_correctness", "true"),
+				),
+			},
+		},
+	})
+}
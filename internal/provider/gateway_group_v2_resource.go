@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_group_v2"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_group_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const gatewayGroupV2ApiPath = "/gateway/v2/group"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayGroupV2Resource{}
+var _ resource.ResourceWithImportState = &GatewayGroupV2Resource{}
+
+func NewGatewayGroupV2Resource() resource.Resource {
+	return &GatewayGroupV2Resource{}
+}
+
+// GatewayGroupV2Resource defines the resource implementation.
+type GatewayGroupV2Resource struct {
+	apiClient *client.Client
+}
+
+// gatewayGroupV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayGroupV2ResourceModel struct {
+	schema.GatewayGroupV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GatewayGroupV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_group_v2"
+}
+
+func (r *GatewayGroupV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, schema.GatewayGroupV2ResourceSchema(ctx))
+}
+
+func (r *GatewayGroupV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+}
+
+func (r *GatewayGroupV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data gatewayGroupV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Create group named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Create group with TF data: %+v", data))
+
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayGroupV2Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Group to create : %+v", gatewayResource))
+
+	apply, err := r.apiClient.Apply(ctx, gatewayGroupV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Group created with result: %s", apply))
+
+	var gatewayRes gateway.GatewayGroupResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as group : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", gatewayRes))
+
+	data.GatewayGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayGroupV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data gatewayGroupV2ResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Read group named %s", data.Name.String()))
+	get, err := r.apiClient.Describe(ctx, gatewayGroupV2Path(data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	if len(get) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Group %s not found, removing from state", data.Name.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var gatewayResource = gateway.GatewayGroupResource{}
+	err = json.Unmarshal(get, &gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", gatewayResource))
+
+	data.GatewayGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayGroupV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data gatewayGroupV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Update group named %s", data.Name.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update group with TF data: %+v", data))
+
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayGroupV2Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Group to update : %+v", gatewayResource))
+
+	apply, err := r.apiClient.Apply(ctx, gatewayGroupV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Group updated with result: %s", apply))
+
+	var gatewayRes gateway.GatewayGroupResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as group : %v, got error: %s", apply.Resource, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("New group state : %+v", gatewayRes))
+
+	data.GatewayGroupV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayGroupV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data gatewayGroupV2ResourceModel
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Delete group named %s", data.Name.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Groups are identified by name in the URL path like Console resources, so the request has no body.
+	err := r.apiClient.Delete(ctx, client.CONSOLE, gatewayGroupV2Path(data.Name.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Group %s deleted", data.Name.String()))
+}
+
+func (r *GatewayGroupV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// gatewayGroupV2Path returns the path of a group, identified by its name alone.
+func gatewayGroupV2Path(name string) string {
+	return fmt.Sprintf("%s/%s", gatewayGroupV2ApiPath, name)
+}
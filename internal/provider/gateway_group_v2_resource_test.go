@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayGroupV2Delete(t *testing.T) {
+	ctx := context.Background()
+	var method, urlPath, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The Gateway client checks its credentials against /metrics when it is made.
+		if r.URL.Path != "/metrics" {
+			b, _ := io.ReadAll(r.Body)
+			method, urlPath, body = r.Method, r.URL.Path, string(b)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(ts.Close)
+	gwClient, err := client.Make(ctx, client.GATEWAY, client.ApiParameter{BaseUrl: ts.URL, CdkUser: "admin", CdkPassword: "secret"}, "test")
+	require.NoError(t, err)
+
+	r := &GatewayGroupV2Resource{apiClient: gwClient}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, state.SetAttribute(ctx, path.Root("name"), "group1").HasError())
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// Groups are deleted by name in the URL path, without any body.
+	assert.Equal(t, http.MethodDelete, method)
+	assert.Equal(t, "/gateway/v2/group/group1", urlPath)
+	assert.Empty(t, body)
+}
+
+func TestAccGatewayGroupV2Resource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resourceRef := "conduktor_gateway_group_v2.test"
+
+	gwClient, err := testClient(client.GATEWAY)
+	if err != nil {
+		t.Fatalf("Error creating gateway client: %s", err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/group_v2/resource_create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-group"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceRef, "spec.members.*", map[string]string{
+						"vcluster": "vcluster_group",
+						"name":     "test-group-member1",
+					}),
+					resource.TestCheckResourceAttr(resourceRef, "spec.external_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceRef, "spec.external_groups.*", "ldap-group"),
+				),
+			},
+			// Importing matches the state of the previous step.
+			{
+				ResourceName:                         resourceRef,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "test-group",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Test plan changes if externally deleted resource
+			{
+				PreConfig: func() {
+					// wait a bit to ensure the group is created
+					time.Sleep(1 * time.Second)
+					t.Logf("Deleting group %s", "test-group")
+					err := gwClient.Delete(context.Background(), client.CONSOLE, gatewayGroupV2Path("test-group"), nil)
+					if err != nil {
+						t.Fatalf("Error externally deleting group: %s", err)
+					}
+				},
+				Config:             providerConfigGateway + test.TestAccTestdata(t, "gateway/group_v2/resource_create.tf"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+					},
+				},
+			},
+			// Re-create and Read testing for update test
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/group_v2/resource_create.tf"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/group_v2/resource_update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "test-group"),
+					resource.TestCheckResourceAttr(resourceRef, "spec.members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceRef, "spec.members.*", map[string]string{
+						"vcluster": "vcluster_group",
+						"name":     "test-group-member2",
+					}),
+					resource.TestCheckNoResourceAttr(resourceRef, "spec.external_groups"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGatewayGroupV2Minimal(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read from minimal example
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/group_v2/resource_minimal.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.minimal", "name", "minimal"),
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.minimal", "spec.external_groups.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGatewayGroupV2ExampleResource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read from simple example
+			{
+				Config: providerConfigGateway + test.TestAccExample(t, "resources", "conduktor_gateway_group_v2", "simple.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.simple", "name", "simple-group"),
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.simple", "spec.members.#", "1"),
+				),
+			},
+			// Create and Read from complex example
+			{
+				Config: providerConfigGateway + test.TestAccExample(t, "resources", "conduktor_gateway_group_v2", "complex.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.complex", "name", "complex-group"),
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.complex", "spec.members.#", "2"),
+					resource.TestCheckResourceAttr("conduktor_gateway_group_v2.complex", "spec.external_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
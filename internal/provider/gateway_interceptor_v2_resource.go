@@ -9,6 +9,7 @@ import (
 	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	schemautils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_interceptor_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,12 +31,18 @@ type GatewayInterceptorV2Resource struct {
 	apiClient *client.Client
 }
 
+// gatewayInterceptorV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayInterceptorV2ResourceModel struct {
+	schema.GatewayInterceptorV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *GatewayInterceptorV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_interceptor_v2"
 }
 
 func (r *GatewayInterceptorV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.GatewayInterceptorV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.GatewayInterceptorV2ResourceSchema(ctx))
 }
 
 func (r *GatewayInterceptorV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,21 +62,22 @@ func (r *GatewayInterceptorV2Resource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.GATEWAY {
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode for this resource. \n"+
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
 }
 
 func (r *GatewayInterceptorV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.GatewayInterceptorV2Model
+	var data gatewayInterceptorV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -77,10 +85,19 @@ func (r *GatewayInterceptorV2Resource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Create interceptor named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create interceptor with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayInterceptorV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create interceptor, got error: %s", err))
 		return
@@ -103,7 +120,7 @@ func (r *GatewayInterceptorV2Resource) Create(ctx context.Context, req resource.
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New interceptor state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayInterceptorV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read interceptor, got error: %s", err))
 		return
@@ -114,7 +131,7 @@ func (r *GatewayInterceptorV2Resource) Create(ctx context.Context, req resource.
 }
 
 func (r *GatewayInterceptorV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.GatewayInterceptorV2Model
+	var data gatewayInterceptorV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -123,6 +140,14 @@ func (r *GatewayInterceptorV2Resource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	queryString := "name=" + data.Name.ValueString()
 	queryString += "&global=false"
 	if data.Scope.Vcluster.ValueString() != "" {
@@ -179,7 +204,7 @@ func (r *GatewayInterceptorV2Resource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	data, err = mapper.InternalModelToTerraform(ctx, matchedInterceptor)
+	data.GatewayInterceptorV2Model, err = mapper.InternalModelToTerraform(ctx, matchedInterceptor)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read interceptor, got error: %s", err))
 		return
@@ -190,7 +215,7 @@ func (r *GatewayInterceptorV2Resource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *GatewayInterceptorV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.GatewayInterceptorV2Model
+	var data gatewayInterceptorV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,10 +224,18 @@ func (r *GatewayInterceptorV2Resource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Update interceptor named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update interceptor with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayInterceptorV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create interceptor, got error: %s", err))
 		return
@@ -224,7 +257,7 @@ func (r *GatewayInterceptorV2Resource) Update(ctx context.Context, req resource.
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New interceptor state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayInterceptorV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read interceptor, got error: %s", err))
 		return
@@ -235,7 +268,7 @@ func (r *GatewayInterceptorV2Resource) Update(ctx context.Context, req resource.
 }
 
 func (r *GatewayInterceptorV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.GatewayInterceptorV2Model
+	var data gatewayInterceptorV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -246,6 +279,14 @@ func (r *GatewayInterceptorV2Resource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deleteScope := gateway.GatewayInterceptorScope{
 		VCluster: data.Scope.Vcluster.ValueString(),
 		Username: data.Scope.Username.ValueString(),
@@ -10,6 +10,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_service_account_v2"
 	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_service_account_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,12 +31,18 @@ type GatewayServiceAccountV2Resource struct {
 	apiClient *client.Client
 }
 
+// gatewayServiceAccountV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayServiceAccountV2ResourceModel struct {
+	schema.GatewayServiceAccountV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *GatewayServiceAccountV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_service_account_v2"
 }
 
 func (r *GatewayServiceAccountV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.GatewayServiceAccountV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.GatewayServiceAccountV2ResourceSchema(ctx))
 }
 
 func (r *GatewayServiceAccountV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,21 +62,22 @@ func (r *GatewayServiceAccountV2Resource) Configure(ctx context.Context, req res
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.GATEWAY {
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode for this resource. \n"+
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
 }
 
 func (r *GatewayServiceAccountV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.GatewayServiceAccountV2Model
+	var data gatewayServiceAccountV2ResourceModel
 	resourceMutex.Lock()
 	defer resourceMutex.Unlock()
 
@@ -79,10 +87,19 @@ func (r *GatewayServiceAccountV2Resource) Create(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Create service account named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create service account with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayServiceAccountV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
 		return
@@ -105,7 +122,7 @@ func (r *GatewayServiceAccountV2Resource) Create(ctx context.Context, req resour
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayServiceAccountV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -116,7 +133,7 @@ func (r *GatewayServiceAccountV2Resource) Create(ctx context.Context, req resour
 }
 
 func (r *GatewayServiceAccountV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.GatewayServiceAccountV2Model
+	var data gatewayServiceAccountV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -125,6 +142,14 @@ func (r *GatewayServiceAccountV2Resource) Read(ctx context.Context, req resource
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Only appending vcluster if present
 	queryString := "name=" + data.Name.ValueString()
 	if data.Vcluster.ValueString() != "" {
@@ -174,7 +199,7 @@ func (r *GatewayServiceAccountV2Resource) Read(ctx context.Context, req resource
 
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", gatewayResource))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayResource)
+	data.GatewayServiceAccountV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayResource)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -185,7 +210,7 @@ func (r *GatewayServiceAccountV2Resource) Read(ctx context.Context, req resource
 }
 
 func (r *GatewayServiceAccountV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.GatewayServiceAccountV2Model
+	var data gatewayServiceAccountV2ResourceModel
 	resourceMutex.Lock()
 	defer resourceMutex.Unlock()
 
@@ -196,10 +221,18 @@ func (r *GatewayServiceAccountV2Resource) Update(ctx context.Context, req resour
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Update service account named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update service account with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayServiceAccountV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
 		return
@@ -221,7 +254,7 @@ func (r *GatewayServiceAccountV2Resource) Update(ctx context.Context, req resour
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New service account state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayServiceAccountV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read service account, got error: %s", err))
 		return
@@ -232,7 +265,7 @@ func (r *GatewayServiceAccountV2Resource) Update(ctx context.Context, req resour
 }
 
 func (r *GatewayServiceAccountV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.GatewayServiceAccountV2Model
+	var data gatewayServiceAccountV2ResourceModel
 	resourceMutex.Lock()
 	defer resourceMutex.Unlock()
 
@@ -245,6 +278,14 @@ func (r *GatewayServiceAccountV2Resource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	deleteRes := gateway.GatewayServiceAccountMetadata{
 		Name:     data.Name.ValueString(),
 		VCluster: data.Vcluster.ValueString(),
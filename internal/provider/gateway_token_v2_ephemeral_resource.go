@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_token_v2"
+	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_token_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &GatewayTokenV2EphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &GatewayTokenV2EphemeralResource{}
+
+func NewGatewayTokenV2EphemeralResource() ephemeral.EphemeralResource {
+	return &GatewayTokenV2EphemeralResource{}
+}
+
+// GatewayTokenV2EphemeralResource defines the ephemeral resource implementation, issuing a token on each run
+// without ever storing it in plan nor state.
+type GatewayTokenV2EphemeralResource struct {
+	apiClient *client.Client
+}
+
+// gatewayTokenV2EphemeralResourceModel is the subset of the generated resource model without the resource only
+// settings, along with the open timeout.
+type gatewayTokenV2EphemeralResourceModel struct {
+	ExpiresAt       types.String   `tfsdk:"expires_at"`
+	IssuedAt        types.String   `tfsdk:"issued_at"`
+	LifetimeSeconds types.Int64    `tfsdk:"lifetime_seconds"`
+	Token           types.String   `tfsdk:"token"`
+	Username        types.String   `tfsdk:"username"`
+	Vcluster        types.String   `tfsdk:"vcluster"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GatewayTokenV2EphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_token_v2"
+}
+
+func (r *GatewayTokenV2EphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	// Same attributes as the resource, except the `rotate_before` setting as a new token is issued on each run.
+	resp.Schema = eschema.Schema{
+		Description:         "Issue a short-lived token for a local service account of a Gateway virtual cluster, never stored in state.",
+		MarkdownDescription: "Issue a short-lived token for a local service account of a Gateway virtual cluster, never stored in state.",
+		Attributes: map[string]eschema.Attribute{
+			"expires_at": eschema.StringAttribute{
+				Computed:            true,
+				Description:         "Expiration time of the token, in RFC3339 format.",
+				MarkdownDescription: "Expiration time of the token, in RFC3339 format.",
+			},
+			"issued_at": eschema.StringAttribute{
+				Computed:            true,
+				Description:         "Issue time of the token, in RFC3339 format.",
+				MarkdownDescription: "Issue time of the token, in RFC3339 format.",
+			},
+			"lifetime_seconds": eschema.Int64Attribute{
+				Required:            true,
+				Description:         "The life time of the token in seconds.",
+				MarkdownDescription: "The life time of the token in seconds.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2147483647),
+				},
+			},
+			"token": eschema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "Response token.",
+				MarkdownDescription: "Response token.",
+			},
+			"username": eschema.StringAttribute{
+				Required:            true,
+				Description:         "The username of the local service account to create the token for.",
+				MarkdownDescription: "The username of the local service account to create the token for.",
+			},
+			"vcluster": eschema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the virtual cluster to create the token for. If not provided, the token will be created in the default passthrough virtual cluster.",
+				MarkdownDescription: "The name of the virtual cluster to create the token for. If not provided, the token will be created in the default passthrough virtual cluster.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9_-]+$"), ""),
+				},
+			},
+		},
+		Blocks: map[string]eschema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (r *GatewayTokenV2EphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this ephemeral resource. \n"+
+				"More info here: \n"+
+				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
+		)
+		return
+	}
+
+	r.apiClient = apiClient
+}
+
+func (r *GatewayTokenV2EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data gatewayTokenV2EphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openTimeout, diags := data.Timeouts.Open(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, openTimeout)
+	defer cancel()
+
+	if data.Vcluster.IsNull() || data.Vcluster.IsUnknown() {
+		data.Vcluster = types.StringValue("passthrough")
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Open ephemeral token for service account %s", data.Username.String()))
+
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &schema.GatewayTokenV2Model{
+		LifetimeSeconds: data.LifetimeSeconds,
+		Username:        data.Username,
+		Vcluster:        data.Vcluster,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Token to create : %+v", gatewayResource))
+
+	apply, err := applyGatewayToken(ctx, r.apiClient, gatewayTokenV2ApiPath, gatewayResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		return
+	}
+
+	var gatewayRes gateway.GatewayTokenResource
+	err = gatewayRes.FromRawJsonInterface(apply.Resource)
+	if err != nil {
+		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as token, got error: %s", err))
+		return
+	}
+	gatewayRes.VCluster = data.Vcluster.ValueString()
+	gatewayRes.Username = data.Username.ValueString()
+	gatewayRes.LifetimeSeconds = data.LifetimeSeconds.ValueInt64()
+
+	tokenModel, err := mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read token, got error: %s", err))
+		return
+	}
+	err = withTokenValidity(&tokenModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate token, got error: %s", err))
+		return
+	}
+	data.Token = tokenModel.Token
+	data.ExpiresAt = tokenModel.ExpiresAt
+	data.IssuedAt = tokenModel.IssuedAt
+
+	// Save data into the ephemeral result, never persisted by Terraform
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -11,7 +11,11 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_token_v2"
 	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_token_v2"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	jsoniter "github.com/json-iterator/go"
 )
@@ -20,6 +24,7 @@ const gatewayTokenV2ApiPath = "/gateway/v2/token"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GatewayTokenV2Resource{}
+var _ resource.ResourceWithModifyPlan = &GatewayTokenV2Resource{}
 
 func NewGatewayTokenV2Resource() resource.Resource {
 	return &GatewayTokenV2Resource{}
@@ -30,12 +35,18 @@ type GatewayTokenV2Resource struct {
 	apiClient *client.Client
 }
 
+// gatewayTokenV2ResourceModel is the generated model along with the operation timeouts.
+type gatewayTokenV2ResourceModel struct {
+	schema.GatewayTokenV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *GatewayTokenV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_token_v2"
 }
 
 func (r *GatewayTokenV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.GatewayTokenV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.GatewayTokenV2ResourceSchema(ctx))
 }
 
 func (r *GatewayTokenV2Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,21 +66,22 @@ func (r *GatewayTokenV2Resource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.GATEWAY {
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode for this resource. \n"+
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
 }
 
 func (r *GatewayTokenV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.GatewayTokenV2Model
+	var data gatewayTokenV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -78,6 +90,14 @@ func (r *GatewayTokenV2Resource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read token for service account %s", data.Username.String()))
 
 	if !data.Token.IsNull() && data.Token.ValueString() != "" {
@@ -92,6 +112,12 @@ func (r *GatewayTokenV2Resource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
+
+		err = withTokenValidity(&data.GatewayTokenV2Model)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate token, got error: %s", err))
+			return
+		}
 	}
 
 	// Save updated data into Terraform state
@@ -99,7 +125,7 @@ func (r *GatewayTokenV2Resource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *GatewayTokenV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.GatewayTokenV2Model
+	var data gatewayTokenV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -107,10 +133,19 @@ func (r *GatewayTokenV2Resource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Create token for service account %s", data.Username.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create token with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayTokenV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create token, got error: %s", err))
 		return
@@ -123,7 +158,7 @@ func (r *GatewayTokenV2Resource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("token created with result: %s", apply))
+	tflog.Debug(ctx, "Token created")
 
 	var gatewayRes gateway.GatewayTokenResource
 	err = gatewayRes.FromRawJsonInterface(apply.Resource)
@@ -131,7 +166,6 @@ func (r *GatewayTokenV2Resource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as token : %v, got error: %s", apply.Resource, err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New token state : %+v", gatewayRes))
 
 	err = gatewayRes.FromRawJsonInterface(apply.Resource)
 	if err != nil {
@@ -141,69 +175,85 @@ func (r *GatewayTokenV2Resource) Create(ctx context.Context, req resource.Create
 	gatewayRes.VCluster = data.Vcluster.ValueString()
 	gatewayRes.Username = data.Username.ValueString()
 	gatewayRes.LifetimeSeconds = data.LifetimeSeconds.ValueInt64()
-	tflog.Debug(ctx, fmt.Sprintf("New token state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	rotateBefore := data.RotateBefore
+	data.GatewayTokenV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read token, got error: %s", err))
 		return
 	}
+	data.RotateBefore = rotateBefore
+
+	err = withTokenValidity(&data.GatewayTokenV2Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate token, got error: %s", err))
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *GatewayTokenV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.GatewayTokenV2Model
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+func (r *GatewayTokenV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
 
+	var plan, state gatewayTokenV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Update token for service account %s", data.Username.String()))
-	tflog.Trace(ctx, fmt.Sprintf("Update token with TF data: %+v", data))
-
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to update token, got error: %s", err))
+	if plan.RotateBefore.IsNull() || plan.RotateBefore.IsUnknown() || state.Token.ValueString() == "" {
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Token to update : %+v", gatewayResource))
 
-	apply, err := applyGatewayToken(ctx, r.apiClient, gatewayTokenV2ApiPath, gatewayResource)
+	rotate, err := tokenInRotationWindow(state.Token.ValueString(), plan.RotateBefore.ValueString(), time.Now())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before"), "Token Rotation Error", fmt.Sprintf("Unable to check token expiration, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Token updated with result: %s", apply))
-
-	var gatewayRes gateway.GatewayTokenResource
-	err = gatewayRes.FromRawJsonInterface(apply.Resource)
-	if err != nil {
-		resp.Diagnostics.AddError("Unmarshall Error", fmt.Sprintf("Response resource can't be cast as token : %v, got error: %s", apply.Resource, err))
+	if !rotate {
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("New token state : %+v", gatewayRes))
-	gatewayRes.VCluster = data.Vcluster.ValueString()
-	gatewayRes.Username = data.Username.ValueString()
-	gatewayRes.LifetimeSeconds = data.LifetimeSeconds.ValueInt64()
-	tflog.Debug(ctx, fmt.Sprintf("New token state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
-	if err != nil {
-		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read token, got error: %s", err))
+	tflog.Info(ctx, fmt.Sprintf("Token for service account %s expires at %s, planning its rotation", state.Username.String(), state.ExpiresAt.String()))
+	plan.Token = types.StringUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	plan.IssuedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("token"))
+}
+
+func (r *GatewayTokenV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state gatewayTokenV2ResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tflog.Info(ctx, fmt.Sprintf("Update token settings for service account %s", data.Username.String()))
+	tflog.Trace(ctx, fmt.Sprintf("Update token with TF data: %+v", data))
+
+	// Changing any of the token attributes replaces the resource, only provider side settings like `rotate_before`
+	// or the timeouts are updated here so the issued token is kept.
+	data.Token = state.Token
+	data.ExpiresAt = state.ExpiresAt
+	data.IssuedAt = state.IssuedAt
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GatewayTokenV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.GatewayTokenV2Model
+	var data gatewayTokenV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -214,31 +264,78 @@ func (r *GatewayTokenV2Resource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("Token deleted for service account: %s", data.Username.String()))
 }
 
 // Function to check if a JWT token is expired.
 func isTokenExpired(tokenString string) (bool, error) {
+	_, expiresAt, err := tokenValidity(tokenString)
+	if err != nil {
+		return false, err
+	}
+	return time.Now().After(expiresAt), nil
+}
+
+// Function to check if a JWT token expires within the rotate before duration from now.
+func tokenInRotationWindow(tokenString string, rotateBefore string, now time.Time) (bool, error) {
+	window, err := time.ParseDuration(rotateBefore)
+	if err != nil {
+		return false, fmt.Errorf("invalid rotate_before duration %q: %s", rotateBefore, err)
+	}
+	_, expiresAt, err := tokenValidity(tokenString)
+	if err != nil {
+		return false, err
+	}
+	return !now.Add(window).Before(expiresAt), nil
+}
+
+// Function to read the issue and expiration times of a JWT token, the issue time is zero if not in the token.
+func tokenValidity(tokenString string) (time.Time, time.Time, error) {
 	// Parse the token
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
-		return false, err
+		return time.Time{}, time.Time{}, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		if exp, ok := claims["exp"].(float64); ok {
-			expirationTime := time.Unix(int64(exp), 0)
-			if time.Now().After(expirationTime) {
-				return true, nil
-			}
-		} else {
-			return false, fmt.Errorf("expiration time not found in token")
-		}
-	} else {
-		return false, fmt.Errorf("unable to parse claims")
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("unable to parse claims")
 	}
 
-	return false, nil
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("expiration time not found in token")
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+
+	var issuedAt time.Time
+	if iat, ok := claims["iat"].(float64); ok {
+		issuedAt = time.Unix(int64(iat), 0)
+	}
+	return issuedAt, expiresAt, nil
+}
+
+// Helper function to set the computed issue and expiration times of a token model from its JWT claims.
+func withTokenValidity(data *schema.GatewayTokenV2Model) error {
+	issuedAt, expiresAt, err := tokenValidity(data.Token.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	data.IssuedAt = types.StringNull()
+	if !issuedAt.IsZero() {
+		data.IssuedAt = types.StringValue(issuedAt.UTC().Format(time.RFC3339))
+	}
+	return nil
 }
 
 // Helper function to issue a new token.
@@ -249,9 +346,11 @@ func applyGatewayToken(ctx context.Context, cli *client.Client, path string, res
 		return client.ApplyResult{}, fmt.Errorf("error marshalling resource: %s", err)
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("POST %s request body : %s", path, string(jsonData)))
+	tflog.Trace(ctx, fmt.Sprintf("POST %s request body : %s", path, cli.RedactBody(jsonData)))
 
-	resp, err := cli.Client.R().SetBody(jsonData).Post(url)
+	resp, err := cli.Execute(ctx, resty.MethodPost, url, func(req *resty.Request) {
+		req.SetBody(jsonData)
+	})
 	if err != nil {
 		return client.ApplyResult{}, err
 	} else if resp.IsError() {
@@ -259,7 +358,7 @@ func applyGatewayToken(ctx context.Context, cli *client.Client, path string, res
 	}
 
 	bodyBytes := resp.Body()
-	tflog.Trace(ctx, fmt.Sprintf("POST %s response body : %s", path, string(bodyBytes)))
+	tflog.Trace(ctx, fmt.Sprintf("POST %s response body : %s", path, cli.RedactBody(bodyBytes)))
 
 	var upsertResponse gateway.GatewayTokenResource
 	err = jsoniter.Unmarshal(bodyBytes, &upsertResponse)
@@ -4,11 +4,19 @@ import (
 	"testing"
 	"time"
 
+	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_token_v2"
 	"github.com/conduktor/terraform-provider-conduktor/internal/test"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccGatewayTokenV2Resource(t *testing.T) {
@@ -34,6 +42,8 @@ func TestAccGatewayTokenV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceRef, "vcluster", "vcluster_sa"),
 					resource.TestCheckResourceAttr(resourceRef, "username", "user10"),
 					resource.TestCheckResourceAttr(resourceRef, "lifetime_seconds", "3000"),
+					resource.TestCheckResourceAttr(resourceRef, "rotate_before", "10m"),
+					resource.TestCheckResourceAttrSet(resourceRef, "expires_at"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -90,6 +100,32 @@ func TestAccGatewayTokenV2ExampleResource(t *testing.T) {
 	})
 }
 
+func TestAccGatewayTokenV2EphemeralResource(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.TestAccPreCheck(t) },
+		// Ephemeral resources are only supported starting with Terraform 1.10
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"conduktor": testAccProtoV6ProviderFactories["conduktor"],
+			"echo":      echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// Token is passed to another provider without being stored for the ephemeral resource itself
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "gateway/token_v2/ephemeral.tf"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("vcluster"), knownvalue.StringExact("passthrough")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("user_passthrough")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
 func TestIsTokenExpired(t *testing.T) {
 	// Helper function to create a token string
 	createToken := func(expirationTime time.Time) string {
@@ -138,3 +174,50 @@ func TestIsTokenExpired(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenInRotationWindow(t *testing.T) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": now.Add(-time.Hour).Unix(),
+		"exp": now.Add(2 * time.Hour).Unix(),
+	})
+	tokenString, _ := token.SignedString([]byte("secret"))
+
+	tests := []struct {
+		name          string
+		rotateBefore  string
+		expected      bool
+		expectedError bool
+	}{
+		{name: "Outside rotation window", rotateBefore: "1h", expected: false},
+		{name: "Inside rotation window", rotateBefore: "3h", expected: true},
+		{name: "Invalid duration", rotateBefore: "soon", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotate, err := tokenInRotationWindow(tokenString, tt.rotateBefore, now)
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, rotate)
+		})
+	}
+}
+
+func TestWithTokenValidity(t *testing.T) {
+	issuedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": issuedAt.Unix(),
+		"exp": issuedAt.Add(time.Hour).Unix(),
+	})
+	tokenString, _ := token.SignedString([]byte("secret"))
+
+	data := schema.GatewayTokenV2Model{Token: types.StringValue(tokenString)}
+	err := withTokenValidity(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue("2025-01-01T10:00:00Z"), data.IssuedAt)
+	assert.Equal(t, types.StringValue("2025-01-01T11:00:00Z"), data.ExpiresAt)
+}
@@ -8,6 +8,7 @@ import (
 	mapper "github.com/conduktor/terraform-provider-conduktor/internal/mapper/gateway_virtual_cluster_v2"
 	gateway "github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_gateway_virtual_cluster_v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -36,12 +37,18 @@ type VirtualClusterV2Resource struct {
 	apiClient *client.Client
 }
 
+// virtualClusterV2ResourceModel is the generated model along with the operation timeouts.
+type virtualClusterV2ResourceModel struct {
+	schema.GatewayVirtualClusterV2Model
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *VirtualClusterV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway_virtual_cluster_v2"
 }
 
 func (r *VirtualClusterV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.GatewayVirtualClusterV2ResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.GatewayVirtualClusterV2ResourceSchema(ctx))
 }
 
 func (r *VirtualClusterV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -61,17 +68,18 @@ func (r *VirtualClusterV2Resource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.GATEWAY {
+	apiClient := data.ClientFor(client.GATEWAY)
+	if apiClient == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode for this resource. \n"+
+			"Gateway Client not configured. Please provide client configuration details for Gateway API and ensure you have set the right provider mode or `gateway` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	gatewayVersion, err := data.Client.GetAPIVersion(ctx, client.GATEWAY)
+	gatewayVersion, err := apiClient.GetAPIVersion(ctx, client.GATEWAY)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching Gateway version", err.Error())
 		return
@@ -92,11 +100,11 @@ func (r *VirtualClusterV2Resource) Configure(ctx context.Context, req resource.C
 		}
 	}
 
-	r.apiClient = data.Client
+	r.apiClient = apiClient
 }
 
 func (r *VirtualClusterV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.GatewayVirtualClusterV2Model
+	var data virtualClusterV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -105,10 +113,18 @@ func (r *VirtualClusterV2Resource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Creating virtual cluster named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create virtual cluster with desired state : %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayVirtualClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create virtual cluster, got error: %s", err))
 		return
@@ -130,7 +146,7 @@ func (r *VirtualClusterV2Resource) Create(ctx context.Context, req resource.Crea
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New virtual cluster state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayVirtualClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read virtual cluster, got error: %s", err))
 		return
@@ -141,7 +157,7 @@ func (r *VirtualClusterV2Resource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *VirtualClusterV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.GatewayVirtualClusterV2Model
+	var data virtualClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -150,6 +166,14 @@ func (r *VirtualClusterV2Resource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Read virtual cluster named %s", data.Name.String()))
 	get, err := r.apiClient.Describe(ctx, fmt.Sprintf("%s/%s", virtualClusterV2ApiPath, data.Name.ValueString()))
 	if err != nil {
@@ -171,7 +195,7 @@ func (r *VirtualClusterV2Resource) Read(ctx context.Context, req resource.ReadRe
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New virtual cluster state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayVirtualClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read virtual cluster, got error: %s", err))
 		return
@@ -182,7 +206,7 @@ func (r *VirtualClusterV2Resource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *VirtualClusterV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.GatewayVirtualClusterV2Model
+	var data virtualClusterV2ResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -191,10 +215,18 @@ func (r *VirtualClusterV2Resource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Updating virtual cluster named %s", data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update virtual cluster with TF data: %+v", data))
 
-	gatewayResource, err := mapper.TFToInternalModel(ctx, &data)
+	gatewayResource, err := mapper.TFToInternalModel(ctx, &data.GatewayVirtualClusterV2Model)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create virtual cluster, got error: %s", err))
 		return
@@ -216,7 +248,7 @@ func (r *VirtualClusterV2Resource) Update(ctx context.Context, req resource.Upda
 	}
 	tflog.Debug(ctx, fmt.Sprintf("New virtual cluster state : %+v", gatewayRes))
 
-	data, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
+	data.GatewayVirtualClusterV2Model, err = mapper.InternalModelToTerraform(ctx, &gatewayRes)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read virtual cluster, got error: %s", err))
 		return
@@ -226,7 +258,7 @@ func (r *VirtualClusterV2Resource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *VirtualClusterV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.GatewayVirtualClusterV2Model
+	var data virtualClusterV2ResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -237,6 +269,14 @@ func (r *VirtualClusterV2Resource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	resourcePath := fmt.Sprintf("%s/%s", virtualClusterV2ApiPath, data.Name.ValueString())
 	// Although this is a Gateway resource, it uses the same mode as the Console API, so we use the CONSOLE mode here.
 	// i.e. ID of the resource is expected in the URL path.
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/conduktor/terraform-provider-conduktor/internal/customtypes"
@@ -11,9 +14,11 @@ import (
 	ctlresource "github.com/conduktor/ctl/resource"
 	ctlschema "github.com/conduktor/ctl/schema"
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_generic"
 	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GenericResource{}
 var _ resource.ResourceWithImportState = &GenericResource{}
+var _ resource.ResourceWithValidateConfig = &GenericResource{}
 
 func NewGenericResource() resource.Resource {
 	return &GenericResource{}
@@ -29,7 +35,14 @@ func NewGenericResource() resource.Resource {
 
 // GenericResource defines the resource implementation.
 type GenericResource struct {
-	client *client.Client
+	// Manifests may mix Console and Gateway kinds, each document is managed through the client of its API.
+	providerData *ProviderData
+}
+
+// genericResourceModel is the generated model along with the operation timeouts.
+type genericResourceModel struct {
+	schema.GenericModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GenericResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +50,7 @@ func (r *GenericResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *GenericResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.GenericResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, schema.GenericResourceSchema(ctx))
 }
 
 func (r *GenericResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,21 +70,59 @@ func (r *GenericResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	if data.Client == nil || data.Mode != client.CONSOLE {
+	if data.ClientFor(client.CONSOLE) == nil && data.ClientFor(client.GATEWAY) == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			"Console Client not configured. Please provide client configuration details for Console API and ensure you have set the right provider mode for this resource. \n"+
+			"No Client configured. Please provide client configuration details for Console or Gateway API and ensure you have set the right provider mode or `console` / `gateway` block for this resource. \n"+
 				"More info here: \n"+
 				" - https://registry.terraform.io/providers/conduktor/conduktor/latest/docs",
 		)
 		return
 	}
 
-	r.client = data.Client
+	r.providerData = data
+}
+
+func (r *GenericResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data genericResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !schemaUtils.AttrIsSet(data.Manifest) {
+		return
+	}
+
+	// Environment variables may only be set at apply time, so they are not required to parse the manifest here.
+	documents, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), false)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid manifest", fmt.Sprintf("Unable to parse manifest, got error: %s", err))
+		return
+	}
+	if len(documents) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid manifest", "Manifest doesn't contain any resource")
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, document := range documents {
+		if !apiVersionRegex.MatchString(document.Version) {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid manifest", fmt.Sprintf("Invalid apiVersion %q in document %d, expected a version like v1", document.Version, i+1))
+			continue
+		}
+		if _, _, err := getKindFromName(document.Kind); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid manifest", fmt.Sprintf("Unsupported resource in document %d: %s", i+1, err))
+			continue
+		}
+		id := documentId(document)
+		if seen[id] {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid manifest", fmt.Sprintf("Resource %s is declared more than once in the manifest", id))
+		}
+		seen[id] = true
+	}
 }
 
 func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data schema.GenericModel
+	var data genericResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -80,32 +131,44 @@ func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Create %s kind named %s", data.Kind.String(), data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Create resource with TF data: %+v", data))
 
-	cliResource, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), true)
-
+	documents, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), true)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create Generic, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Resource to create : %+v", cliResource))
+	tflog.Debug(ctx, fmt.Sprintf("Resources to create : %+v", documents))
 
-	firstResource := cliResource[0]
-	apply, err := r.client.ApplyGeneric(ctx, firstResource)
+	applied, err := r.applyDocuments(ctx, documents)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Generic, got error: %s", err))
+		if len(applied) > 0 {
+			// Keep track of the resources already created, so that they are cleaned up when the resource gets replaced.
+			manifest, err := documentsManifest(applied)
+			if err == nil {
+				data.Manifest = customtypes.NewNormalizedValue(manifest)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			}
+		}
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Resource created with result: %s", apply))
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data schema.GenericModel
+	var data genericResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -114,61 +177,114 @@ func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Read %s kind named %s", data.Kind.String(), data.Name.String()))
-	resourcePath, err := resourcePath(data)
-	if err != nil {
-		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to build Generic api path, got error: \"%s\" from kind:%s name:%s (cluster:%s)", err, data.Kind.ValueString(), data.Name.ValueString(), data.Cluster.ValueString()))
-		return
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Query resource on path %s", resourcePath))
-	get, err := r.client.Describe(ctx, resourcePath)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	if len(get) == 0 {
-		tflog.Debug(ctx, fmt.Sprintf("Resource %s not found, removing from state", resourcePath))
-		resp.State.RemoveResource(ctx)
-		return
-	}
+	tflog.Info(ctx, fmt.Sprintf("Read %s kind named %s", data.Kind.String(), data.Name.String()))
 
-	cliResource, err := ctlresource.FromYamlByte(get, true)
-	if err != nil {
-		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic resource, got error: %s", err))
-		return
+	// Each document of the manifest is read on its own path, or the resource attributes are used without manifest.
+	type readTarget struct {
+		documentTarget
+		prior string
 	}
-	if len(cliResource) != 1 {
-		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Received more than one resource on response : %v", cliResource))
-		return
+	targets := []readTarget{}
+	if prior := data.Manifest.ValueString(); strings.TrimSpace(prior) != "" {
+		documents, err := ctlresource.FromYamlByte([]byte(prior), true)
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic manifest, got error: %s", err))
+			return
+		}
+		for _, document := range documents {
+			target, err := documentTargetFor(document)
+			if err != nil {
+				resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to build Generic api path, got error: \"%s\" from kind:%s name:%s", err, document.Kind, document.Name))
+				return
+			}
+			priorYaml, err := yaml.JSONToYAML(document.Json)
+			if err != nil {
+				resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic manifest, got error: %s", err))
+				return
+			}
+			targets = append(targets, readTarget{documentTarget: target, prior: string(priorYaml)})
+		}
+	} else {
+		resourcePath, err := resourcePath(data.GenericModel)
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to build Generic api path, got error: \"%s\" from kind:%s name:%s (cluster:%s)", err, data.Kind.ValueString(), data.Name.ValueString(), data.Cluster.ValueString()))
+			return
+		}
+		targets = append(targets, readTarget{documentTarget: documentTarget{mode: client.CONSOLE, path: resourcePath, deletePath: resourcePath}})
 	}
 
-	firstResource := cliResource[0]
+	var mainResource *ctlresource.Resource
+	yamlDocuments := []string{}
+	for _, target := range targets {
+		apiClient, err := r.clientFor(target.mode)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
+			return
+		}
 
-	tflog.Trace(ctx, fmt.Sprintf("New resource JSON state : %s", string(firstResource.Json)))
+		tflog.Debug(ctx, fmt.Sprintf("Query resource on path %s", target.path))
+		get, err := apiClient.Describe(ctx, target.path)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
+			return
+		}
 
-	outBytes, err := yaml.JSONToYAML(firstResource.Json)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
-		return
-	}
-	yamlString := string(outBytes)
+		readResource, found, err := target.resourceFromResponse(get)
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic resource, got error: %s", err))
+			return
+		}
+		if !found {
+			// Dropped from the manifest in state, so that the next apply creates it again.
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s not found", target.path))
+			continue
+		}
 
-	// Keep only user-declared fields so server-computed ones (promQl, updatedAt...)
-	// don't cause a perpetual diff. Best-effort: fall back to the raw response.
-	if prior := data.Manifest.ValueString(); strings.TrimSpace(prior) != "" {
-		if reconciled, rerr := reconcileManifest(prior, yamlString); rerr != nil {
-			tflog.Warn(ctx, fmt.Sprintf("Unable to reconcile Generic manifest, using raw response: %s", rerr))
-		} else {
-			yamlString = reconciled
+		if mainResource == nil || (readResource.Kind == data.Kind.ValueString() && readResource.Name == data.Name.ValueString()) {
+			mainResource = &readResource
 		}
+
+		tflog.Trace(ctx, fmt.Sprintf("New resource JSON state : %s", string(readResource.Json)))
+
+		outBytes, err := yaml.JSONToYAML(readResource.Json)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
+			return
+		}
+		yamlString := string(outBytes)
+
+		// Keep only user-declared fields so server-computed ones (promQl, updatedAt...)
+		// don't cause a perpetual diff. Best-effort: fall back to the raw response.
+		if target.prior != "" {
+			if reconciled, rerr := reconcileManifest(target.prior, yamlString); rerr != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Unable to reconcile Generic manifest, using raw response: %s", rerr))
+			} else {
+				yamlString = reconciled
+			}
+		}
+		yamlDocuments = append(yamlDocuments, yamlString)
 	}
+
+	if mainResource == nil {
+		tflog.Debug(ctx, "No resource of the manifest found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	yamlString := strings.Join(yamlDocuments, "---\n")
 	tflog.Trace(ctx, fmt.Sprintf("New resource YAML state : %s", yamlString))
 
-	data.Kind = schemaUtils.NewStringValue(firstResource.Kind)
-	data.Name = schemaUtils.NewStringValue(firstResource.Name)
-	data.Version = schemaUtils.NewStringValue(firstResource.Version)
+	data.Kind = schemaUtils.NewStringValue(mainResource.Kind)
+	data.Name = schemaUtils.NewStringValue(mainResource.Name)
+	data.Version = schemaUtils.NewStringValue(mainResource.Version)
 	data.Manifest = customtypes.NewNormalizedValue(yamlString)
 
 	// Save updated data into Terraform state
@@ -176,39 +292,69 @@ func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *GenericResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data schema.GenericModel
+	var data genericResourceModel
+	var state genericResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Update %s kind named %s", data.Kind.String(), data.Name.String()))
 	tflog.Trace(ctx, fmt.Sprintf("Update resource with TF data: %+v", data))
 
-	cliResource, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), true)
+	documents, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), true)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to create Generic, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Resource to update : %+v", cliResource))
+	tflog.Debug(ctx, fmt.Sprintf("Resources to update : %+v", documents))
 
-	firstResource := cliResource[0]
-	apply, err := r.client.ApplyGeneric(ctx, firstResource)
+	_, err = r.applyDocuments(ctx, documents)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Generic, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Resource updated with result: %s", apply))
+
+	// Delete the resources removed from the manifest.
+	priorDocuments, err := ctlresource.FromYamlByte([]byte(state.Manifest.ValueString()), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic prior manifest, got error: %s", err))
+		return
+	}
+	planned := map[string]bool{}
+	for _, document := range documents {
+		planned[documentId(document)] = true
+	}
+	removed := []ctlresource.Resource{}
+	for _, document := range priorDocuments {
+		if !planned[documentId(document)] {
+			removed = append(removed, document)
+		}
+	}
+	err = r.deleteDocuments(ctx, removed)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Generic resource removed from manifest, got error: %s", err))
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data schema.GenericModel
+	var data genericResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -217,40 +363,136 @@ func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, fmt.Sprintf("Delete %s kind named %s", data.Kind.String(), data.Name.String()))
-	resourcePath, err := resourcePath(data)
+
+	if strings.TrimSpace(data.Manifest.ValueString()) != "" {
+		documents, err := ctlresource.FromYamlByte([]byte(data.Manifest.ValueString()), true)
+		if err != nil {
+			resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic manifest, got error: %s", err))
+			return
+		}
+		err = r.deleteDocuments(ctx, documents)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Generic, got error: %s", err))
+		}
+		return
+	}
+
+	resourcePath, err := resourcePath(data.GenericModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Model Error", fmt.Sprintf("Unable to read Generic, got error: %s", err))
 		return
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Delete resource on path %s", resourcePath))
 
-	err = r.client.Delete(ctx, client.CONSOLE, resourcePath, nil)
+	apiClient, err := r.clientFor(client.CONSOLE)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Generic, got error: %s", err))
 		return
 	}
+	err = apiClient.Delete(ctx, client.CONSOLE, resourcePath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Generic, got error: %s", err))
+		return
+	}
+}
+
+// applyDocuments applies the manifest documents in dependency order, returning the ones applied until an error occurs.
+func (r *GenericResource) applyDocuments(ctx context.Context, documents []ctlresource.Resource) ([]ctlresource.Resource, error) {
+	sorted := slices.Clone(documents)
+	ctlschema.SortResourcesForApply(genericKindCatalog(), sorted, false)
+
+	applied := []ctlresource.Resource{}
+	for _, document := range sorted {
+		_, mode, err := getKindFromName(document.Kind)
+		if err != nil {
+			return applied, fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+		apiClient, err := r.clientFor(mode)
+		if err != nil {
+			return applied, fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+		apply, err := apiClient.ApplyGeneric(ctx, mode, document)
+		if err != nil {
+			return applied, fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Resource %s %s applied with result: %s", document.Kind, document.Name, apply))
+		applied = append(applied, document)
+	}
+	return applied, nil
+}
+
+// deleteDocuments deletes the manifest documents in reverse dependency order.
+func (r *GenericResource) deleteDocuments(ctx context.Context, documents []ctlresource.Resource) error {
+	sorted := slices.Clone(documents)
+	ctlschema.SortResourcesForDelete(genericKindCatalog(), sorted, false)
+
+	for _, document := range sorted {
+		target, err := documentTargetFor(document)
+		if err != nil {
+			return fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+		apiClient, err := r.clientFor(target.mode)
+		if err != nil {
+			return fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Delete resource on path %s", target.deletePath))
+
+		err = apiClient.Delete(ctx, target.deleteMode(), target.deletePath, target.deleteBody)
+		if err != nil {
+			return fmt.Errorf("%s %s: %s", document.Kind, document.Name, err)
+		}
+	}
+	return nil
+}
+
+// clientFor returns the client of the API serving kinds of the given mode, or an error if the provider is not
+// configured for it.
+func (r *GenericResource) clientFor(mode client.Mode) (*client.Client, error) {
+	apiClient := r.providerData.ClientFor(mode)
+	if apiClient == nil {
+		return nil, fmt.Errorf("%s client not configured, set the right provider mode or `%s` block to manage this kind", mode, strings.ToLower(string(mode)))
+	}
+	return apiClient, nil
 }
 
 func (r *GenericResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
-// Search for the kind in the CLI default schema.
-func getKindFromName(kindName string) (ctlschema.Kind, error) {
-	catalog := ctlschema.ConsoleDefaultCatalog() // TODO support gateway kinds and client too
-	kinds := catalog.Kind
-	kind, ok := kinds[kindName]
-	if !ok {
-		return ctlschema.Kind{}, fmt.Errorf("kind %s not found", kindName)
+// Version of a manifest document, checked before handing it to the CLI which exits on invalid ones.
+var apiVersionRegex = regexp.MustCompile(`v\d+`)
+
+// Search for the kind in the CLI default schemas, returning the mode of the API serving it.
+func getKindFromName(kindName string) (ctlschema.Kind, client.Mode, error) {
+	for _, mode := range []client.Mode{client.CONSOLE, client.GATEWAY} {
+		kind, ok := client.DefaultKindCatalog(mode)[kindName]
+		if ok {
+			return kind, mode, nil
+		}
 	}
 
-	return kind, nil
+	return ctlschema.Kind{}, "", fmt.Errorf("kind %s not found", kindName)
+}
+
+// genericKindCatalog merges the Console and Gateway catalogs to order the documents of a manifest, like the CLI does.
+func genericKindCatalog() ctlschema.KindCatalog {
+	console := ctlschema.Catalog{Kind: client.DefaultKindCatalog(client.CONSOLE)}
+	gateway := ctlschema.Catalog{Kind: client.DefaultKindCatalog(client.GATEWAY)}
+	return console.Merge(&gateway).Kind
 }
 
 // Generate the resource path for the given kind, cluster and resource name.
 func resourcePath(data schema.GenericModel) (string, error) {
-	kind, err := getKindFromName(data.Kind.ValueString())
+	kind, _, err := getKindFromName(data.Kind.ValueString())
 	if err != nil {
 		return "", err
 	}
@@ -273,6 +515,199 @@ func resourcePath(data schema.GenericModel) (string, error) {
 	return appendQueryParams(describe.Path, queryParams), nil
 }
 
+// documentPath generates the resource path of a manifest document, using its metadata for the parent path and
+// query params.
+func documentPath(document ctlresource.Resource) (string, error) {
+	kind, _, err := getKindFromName(document.Kind)
+	if err != nil {
+		return "", err
+	}
+
+	path, queryParamsByName, err := kind.DeletePath(&document)
+	if err != nil {
+		return "", err
+	}
+	queryParams := []ctlschema.QueryParam{}
+	for name, value := range queryParamsByName {
+		queryParams = append(queryParams, ctlschema.QueryParam{Name: name, Value: value})
+	}
+	return appendQueryParams(path, queryParams), nil
+}
+
+// documentTarget describes how to read and delete a manifest document through the API serving its kind.
+type documentTarget struct {
+	mode client.Mode
+	// path reads the document, or lists the resources matching it for Gateway kinds that can't be read by name.
+	path string
+	list bool
+	// identity of the document among the listed resources.
+	identity   string
+	deletePath string
+	// deleteBody identifies the document in the request body for Gateway kinds deleted this way.
+	deleteBody any
+}
+
+// documentTargetFor resolves the target of a manifest document following the CLI semantics: Console kinds and
+// Gateway kinds the Gateway catalog describes as readable by name have their own resource path, while other Gateway
+// kinds are identified by their name and virtual cluster or interceptor scope.
+func documentTargetFor(document ctlresource.Resource) (documentTarget, error) {
+	kind, mode, err := getKindFromName(document.Kind)
+	if err != nil {
+		return documentTarget{}, err
+	}
+
+	if mode == client.CONSOLE {
+		documentPath, err := documentPath(document)
+		if err != nil {
+			return documentTarget{}, err
+		}
+		return documentTarget{mode: mode, path: documentPath, deletePath: documentPath}, nil
+	}
+
+	listPath := kind.ListPath(nil, nil).Path
+	switch {
+	case document.Kind == gatewayInterceptorCatalogKind:
+		return interceptorTarget(document, mode, listPath), nil
+	case !gatewayKindGetAvailable(kind):
+		vCluster := metadataString(document.Metadata, "vCluster")
+		if vCluster == "" {
+			vCluster = "passthrough"
+		}
+		return documentTarget{
+			mode: mode,
+			path: appendQueryParams(listPath, []ctlschema.QueryParam{
+				{Name: "name", Value: document.Name},
+				{Name: "vcluster", Value: vCluster},
+			}),
+			list:       true,
+			identity:   gatewayIdentity(document),
+			deletePath: listPath,
+			deleteBody: map[string]string{"name": document.Name, "vCluster": vCluster},
+		}, nil
+	default:
+		documentPath := listPath + "/" + url.PathEscape(document.Name)
+		return documentTarget{mode: mode, path: documentPath, deletePath: documentPath}, nil
+	}
+}
+
+// gatewayInterceptorCatalogKind is the name of the interceptor kind in the Gateway catalog.
+const gatewayInterceptorCatalogKind = "Interceptor"
+
+// gatewayKindGetAvailable tells whether the Gateway catalog describes a kind as readable by name on its own path.
+// Other Gateway kinds are only listed, and identified by their name and virtual cluster.
+func gatewayKindGetAvailable(kind ctlschema.Kind) bool {
+	kindVersion, ok := kind.GetLatestKindVersion().(*ctlschema.GatewayKindVersion)
+	return !ok || kindVersion.GetAvailable
+}
+
+// interceptorTarget returns the target of an interceptor, listed and deleted within its scope.
+func interceptorTarget(document ctlresource.Resource, mode client.Mode, listPath string) documentTarget {
+	scope := interceptorScope(document)
+	queryParams := []ctlschema.QueryParam{
+		{Name: "name", Value: document.Name},
+		{Name: "global", Value: "false"},
+	}
+	for name, value := range map[string]string{"vcluster": scope.VCluster, "username": scope.Username, "group": scope.Group} {
+		if value != "" {
+			queryParams = append(queryParams, ctlschema.QueryParam{Name: name, Value: value})
+		}
+	}
+	return documentTarget{
+		mode:       mode,
+		path:       appendQueryParams(listPath, queryParams),
+		list:       true,
+		identity:   gatewayIdentity(document),
+		deletePath: listPath + "/" + url.PathEscape(document.Name),
+		deleteBody: scope,
+	}
+}
+
+// deleteMode is the mode of the delete request, Gateway kinds identified by their path are deleted without body.
+func (t documentTarget) deleteMode() client.Mode {
+	if t.deleteBody == nil {
+		return client.CONSOLE
+	}
+	return client.GATEWAY
+}
+
+// resourceFromResponse extracts the document from the read response, found is false if it doesn't exist anymore.
+func (t documentTarget) resourceFromResponse(body []byte) (resource ctlresource.Resource, found bool, err error) {
+	if len(body) == 0 {
+		return ctlresource.Resource{}, false, nil
+	}
+
+	if t.list {
+		listed := []ctlresource.Resource{}
+		if err := json.Unmarshal(body, &listed); err != nil {
+			return ctlresource.Resource{}, false, err
+		}
+		for _, resource := range listed {
+			if gatewayIdentity(resource) == t.identity {
+				return resource, true, nil
+			}
+		}
+		return ctlresource.Resource{}, false, nil
+	}
+
+	resources, err := ctlresource.FromYamlByte(body, true)
+	if err != nil {
+		return ctlresource.Resource{}, false, err
+	}
+	if len(resources) != 1 {
+		return ctlresource.Resource{}, false, fmt.Errorf("received more than one resource on response : %v", resources)
+	}
+	return resources[0], true, nil
+}
+
+// gatewayIdentity identifies a Gateway resource listed by name, where the API omits the passthrough virtual cluster.
+func gatewayIdentity(resource ctlresource.Resource) string {
+	if resource.Kind == gatewayInterceptorCatalogKind {
+		scope := interceptorScope(resource)
+		return strings.Join([]string{resource.Name, scope.VCluster, scope.Group, scope.Username}, "/")
+	}
+	vCluster := metadataString(resource.Metadata, "vCluster")
+	if vCluster == "" {
+		vCluster = "passthrough"
+	}
+	return resource.Name + "/" + vCluster
+}
+
+func interceptorScope(resource ctlresource.Resource) gateway.GatewayInterceptorScope {
+	scope, _ := resource.Metadata["scope"].(map[string]any)
+	return gateway.GatewayInterceptorScope{
+		VCluster: metadataString(scope, "vCluster"),
+		Group:    metadataString(scope, "group"),
+		Username: metadataString(scope, "username"),
+	}
+}
+
+func metadataString(metadata map[string]any, key string) string {
+	value, _ := metadata[key].(string)
+	return value
+}
+
+// documentId identifies a manifest document by its kind and resource path.
+func documentId(document ctlresource.Resource) string {
+	target, err := documentTargetFor(document)
+	if err != nil {
+		return document.Kind + " " + document.Name
+	}
+	return document.Kind + " " + target.path
+}
+
+// documentsManifest renders the given documents as a multi-document YAML manifest.
+func documentsManifest(documents []ctlresource.Resource) (string, error) {
+	yamlDocuments := []string{}
+	for _, document := range documents {
+		outBytes, err := yaml.JSONToYAML(document.Json)
+		if err != nil {
+			return "", err
+		}
+		yamlDocuments = append(yamlDocuments, string(outBytes))
+	}
+	return strings.Join(yamlDocuments, "---\n"), nil
+}
+
 // parentQueryParams extracts the kind's parent query params from the manifest
 // metadata, reusing ctl's Kind.ApplyPath (the same extraction Create uses).
 func parentQueryParams(kind ctlschema.Kind, manifest string) ([]ctlschema.QueryParam, error) {
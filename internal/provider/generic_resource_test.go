@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
+	ctlresource "github.com/conduktor/ctl/resource"
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/conduktor/terraform-provider-conduktor/internal/model/gateway"
 	"github.com/conduktor/terraform-provider-conduktor/internal/test"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Alert v3 was introduced in Console 1.30.
@@ -93,6 +98,245 @@ func TestAccGenericAlertResource(t *testing.T) {
 	})
 }
 
+// Group is declared before its member User, so this checks documents are applied in dependency order, and that
+// the User removed from the manifest on update gets deleted.
+func TestAccGenericBundleResource(t *testing.T) {
+	resourceRef := "conduktor_generic.bundle"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create + Read
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "generic_resource_create_bundle.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "generic-bundle-group"),
+					resource.TestCheckResourceAttr(resourceRef, "kind", "Group"),
+					resource.TestCheckResourceAttrWith(resourceRef, "manifest",
+						test.TestCheckResourceAttrContainsStringsFunc(
+							"displayName: Generic bundle group",
+							"firstName: Dwight",
+						)),
+				),
+			},
+			// Update + Read (User removed from the manifest)
+			{
+				Config: providerConfigConsole + test.TestAccTestdata(t, "generic_resource_update_bundle.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "generic-bundle-group"),
+					resource.TestCheckResourceAttrWith(resourceRef, "manifest",
+						test.TestCheckResourceAttrContainsStringsFunc(
+							"displayName: Generic bundle group updated",
+						)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// Gateway kinds are managed through the Gateway API, the service account identified by name and virtual cluster is
+// read by listing, and the group removed from the manifest on update gets deleted.
+func TestAccGenericGatewayBundleResource(t *testing.T) {
+	resourceRef := "conduktor_generic.gateway_bundle"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create + Read
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "generic_resource_create_gateway_bundle.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "generic-gateway-group"),
+					resource.TestCheckResourceAttr(resourceRef, "kind", "GatewayGroup"),
+					resource.TestCheckResourceAttrWith(resourceRef, "manifest",
+						test.TestCheckResourceAttrContainsStringsFunc(
+							"name: generic-gateway-sa",
+							"- generic-external-name",
+						)),
+				),
+			},
+			// Update + Read (GatewayGroup removed from the manifest)
+			{
+				Config: providerConfigGateway + test.TestAccTestdata(t, "generic_resource_update_gateway_bundle.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRef, "name", "generic-gateway-sa"),
+					resource.TestCheckResourceAttr(resourceRef, "kind", "GatewayServiceAccount"),
+					resource.TestCheckResourceAttrWith(resourceRef, "manifest",
+						test.TestCheckResourceAttrContainsStringsFunc(
+							"- generic-external-name-updated",
+						)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGenericInvalidManifest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfigConsole + test.TestAccTestdata(t, "generic_resource_invalid_bundle.tf"),
+				ExpectError: regexp.MustCompile(`declared more than once`),
+			},
+		},
+	})
+}
+
+func TestDocumentPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		expected string
+	}{
+		{
+			name:     "Root kind",
+			manifest: "apiVersion: v2\nkind: User\nmetadata:\n  name: jim@dunder.mifflin.com\n",
+			expected: "/public/iam/v2/user/jim@dunder.mifflin.com",
+		},
+		{
+			name:     "Kind with parent path",
+			manifest: "apiVersion: v2\nkind: Topic\nmetadata:\n  name: orders\n  cluster: kafka-cluster\n",
+			expected: "/public/kafka/v2/cluster/kafka-cluster/topic/orders",
+		},
+		{
+			name:     "Kind with parent query param",
+			manifest: "apiVersion: v3\nkind: Alert\nmetadata:\n  name: lag\n  user: admin@conduktor.io\n",
+			expected: "/public/monitoring/v3/alert/lag?user=admin%40conduktor.io",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := ctlresource.FromYamlByte([]byte(tt.manifest), true)
+			require.NoError(t, err)
+			require.Len(t, documents, 1)
+			documentPath, err := documentPath(documents[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, documentPath)
+		})
+	}
+}
+
+func TestDocumentTargetGateway(t *testing.T) {
+	tests := []struct {
+		name       string
+		manifest   string
+		path       string
+		list       bool
+		deletePath string
+		deleteBody any
+	}{
+		{
+			name:       "Kind identified by name",
+			manifest:   "apiVersion: gateway/v2\nkind: GatewayGroup\nmetadata:\n  name: group1\n",
+			path:       "/gateway/v2/group/group1",
+			deletePath: "/gateway/v2/group/group1",
+		},
+		{
+			name:       "Virtual cluster identified by name",
+			manifest:   "apiVersion: gateway/v2\nkind: VirtualCluster\nmetadata:\n  name: vcluster1\n",
+			path:       "/gateway/v2/virtual-cluster/vcluster1",
+			deletePath: "/gateway/v2/virtual-cluster/vcluster1",
+		},
+		{
+			name:       "Kind identified by name and virtual cluster",
+			manifest:   "apiVersion: gateway/v2\nkind: AliasTopic\nmetadata:\n  name: alias\n  vCluster: vcluster1\n",
+			path:       "/gateway/v2/alias-topic?name=alias&vcluster=vcluster1",
+			list:       true,
+			deletePath: "/gateway/v2/alias-topic",
+			deleteBody: map[string]string{"name": "alias", "vCluster": "vcluster1"},
+		},
+		{
+			name:       "Kind on passthrough virtual cluster",
+			manifest:   "apiVersion: gateway/v2\nkind: ConcentrationRule\nmetadata:\n  name: rule\n",
+			path:       "/gateway/v2/concentration-rule?name=rule&vcluster=passthrough",
+			list:       true,
+			deletePath: "/gateway/v2/concentration-rule",
+			deleteBody: map[string]string{"name": "rule", "vCluster": "passthrough"},
+		},
+		{
+			name:       "Interceptor scoped to a group",
+			manifest:   "apiVersion: gateway/v2\nkind: Interceptor\nmetadata:\n  name: enforce-partition\n  scope:\n    group: group1\n",
+			path:       "/gateway/v2/interceptor?global=false&group=group1&name=enforce-partition",
+			list:       true,
+			deletePath: "/gateway/v2/interceptor/enforce-partition",
+			deleteBody: gateway.GatewayInterceptorScope{Group: "group1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := ctlresource.FromYamlByte([]byte(tt.manifest), true)
+			require.NoError(t, err)
+			require.Len(t, documents, 1)
+			target, err := documentTargetFor(documents[0])
+			require.NoError(t, err)
+			assert.Equal(t, client.GATEWAY, target.mode)
+			assert.Equal(t, tt.path, target.path)
+			assert.Equal(t, tt.list, target.list)
+			assert.Equal(t, tt.deletePath, target.deletePath)
+			assert.Equal(t, tt.deleteBody, target.deleteBody)
+		})
+	}
+}
+
+func TestDocumentTargetListedResource(t *testing.T) {
+	documents, err := ctlresource.FromYamlByte([]byte("apiVersion: gateway/v2\nkind: GatewayServiceAccount\nmetadata:\n  name: sa1\n"), true)
+	require.NoError(t, err)
+	target, err := documentTargetFor(documents[0])
+	require.NoError(t, err)
+
+	// The Gateway API omits the passthrough virtual cluster, and lists resources with a name containing the query one.
+	listed := `[
+		{"apiVersion": "gateway/v2", "kind": "GatewayServiceAccount", "metadata": {"name": "sa1", "vCluster": "vcluster1"}, "spec": {"type": "LOCAL"}},
+		{"apiVersion": "gateway/v2", "kind": "GatewayServiceAccount", "metadata": {"name": "sa1"}, "spec": {"type": "LOCAL"}},
+		{"apiVersion": "gateway/v2", "kind": "GatewayServiceAccount", "metadata": {"name": "sa10"}, "spec": {"type": "LOCAL"}}
+	]`
+	found, ok, err := target.resourceFromResponse([]byte(listed))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "sa1", found.Name)
+	assert.Nil(t, found.Metadata["vCluster"])
+
+	_, ok, err = target.resourceFromResponse([]byte(`[]`))
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestGatewayIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource ctlresource.Resource
+		expected string
+	}{
+		{
+			name:     "Passthrough virtual cluster",
+			resource: ctlresource.Resource{Kind: "GatewayServiceAccount", Name: "sa1", Metadata: map[string]any{"name": "sa1"}},
+			expected: "sa1/passthrough",
+		},
+		{
+			name:     "Interceptor scope",
+			resource: ctlresource.Resource{Kind: "Interceptor", Name: "masking", Metadata: map[string]any{"name": "masking", "scope": map[string]any{"vCluster": "vcluster1", "username": "user1"}}},
+			expected: "masking/vcluster1//user1",
+		},
+		{
+			name:     "Kind only named like an interceptor",
+			resource: ctlresource.Resource{Kind: "InterceptorPlugin", Name: "plugin", Metadata: map[string]any{"name": "plugin", "vCluster": "vcluster1"}},
+			expected: "plugin/vcluster1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, gatewayIdentity(tt.resource))
+		})
+	}
+}
+
 func TestAccGenericExample2Resource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { test.TestAccPreCheck(t) },
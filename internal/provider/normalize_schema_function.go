@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeSchemaFunction{}
+
+func NewNormalizeSchemaFunction() function.Function {
+	return &NormalizeSchemaFunction{}
+}
+
+// NormalizeSchemaFunction defines the function implementation.
+type NormalizeSchemaFunction struct{}
+
+func (f *NormalizeSchemaFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_schema"
+}
+
+func (f *NormalizeSchemaFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize an Avro, Protobuf or JSON schema",
+		MarkdownDescription: "Returns the canonical form of an Avro, Protobuf or JSON schema, the same one `conduktor_console_kafka_subject_v2` uses to ignore formatting changes. " +
+			"Two semantically equal schemas have the same normalized form, which can then be hashed or compared. Schemas of unknown format are returned as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "Schema to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeSchemaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schema string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schema))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := customtypes.NormalizeSchema(schema)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to normalize %s schema, got error: %s", customtypes.DetectSchemaFormat(schema), err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
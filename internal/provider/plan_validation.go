@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// planToValidate tells whether a planned Console resource should go through the dry-run apply when the provider
+// `validate_on_plan` is enabled. Destroyed and unchanged resources are skipped, as well as configurations with
+// values only known at apply.
+func planToValidate(ctx context.Context, validateOnPlan bool, req resource.ModifyPlanRequest) bool {
+	if !validateOnPlan || req.Plan.Raw.IsNull() {
+		return false
+	}
+	if !req.State.Raw.IsNull() && req.State.Raw.Equal(req.Plan.Raw) {
+		return false
+	}
+	if !req.Config.Raw.IsFullyKnown() {
+		tflog.Debug(ctx, "Configuration has unknown values, skipping plan validation")
+		return false
+	}
+	return true
+}
+
+// dryRunApply sends the planned resource to the Console apply endpoint in dry-run mode, reporting its rejection as
+// an error on the resource spec.
+func dryRunApply(ctx context.Context, apiClient *client.Client, putPath string, resource any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, fmt.Sprintf("Validating planned resource on %s", putPath))
+	result, err := apiClient.DryRunApply(ctx, putPath, resource)
+	if err != nil {
+		diags.AddAttributeError(path.Root("spec"), "Rejected by Console",
+			fmt.Sprintf("Console would reject this resource on apply, got error: %s", err))
+		return diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Planned resource validated with result: %s", result.UpsertResult))
+
+	return diags
+}
+
+// validatePlannedResource validates a planned Console resource, read from the plan into its resource model M and
+// mapped to its Console model R by toConsole. The checks run first, each of them being enabled by its own provider
+// option, then the resource goes through the dry-run apply on putPath when `validate_on_plan` is enabled.
+// Plans that can't be mapped yet are not validated.
+func validatePlannedResource[M, R any](
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	apiClient *client.Client, validateOnPlan bool, description string,
+	toConsole func(context.Context, *M) (R, error), putPath func(R) (string, error), checks ...func(R) diag.Diagnostics,
+) {
+	if !planToValidate(ctx, validateOnPlan || len(checks) > 0, req) {
+		return
+	}
+
+	var data M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	consoleResource, err := toConsole(ctx, &data)
+	if err != nil {
+		// Best effort, values computed on apply may not be mapped yet.
+		tflog.Debug(ctx, fmt.Sprintf("Unable to validate %s on plan, got error: %s", description, err))
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, check := range checks {
+		resp.Diagnostics.Append(check(consoleResource)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !validateOnPlan {
+		return
+	}
+	applyPath, err := putPath(consoleResource)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to validate %s on plan, got error: %s", description, err))
+		return
+	}
+	resp.Diagnostics.Append(dryRunApply(ctx, apiClient, applyPath, consoleResource)...)
+}
+
+// staticPutPath returns the putPath of validatePlannedResource for resources applied on a fixed path.
+func staticPutPath[R any](putPath string) func(R) (string, error) {
+	return func(R) (string, error) {
+		return putPath, nil
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanToValidate(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	value := func(name any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	tests := []struct {
+		name           string
+		validateOnPlan bool
+		config         tftypes.Value
+		state          tftypes.Value
+		plan           tftypes.Value
+		expected       bool
+	}{
+		{"Disabled", false, value("a"), null, value("a"), false},
+		{"Create", true, value("a"), null, value("a"), true},
+		{"Update", true, value("b"), value("a"), value("b"), true},
+		{"Unchanged", true, value("a"), value("a"), value("a"), false},
+		{"Destroy", true, null, value("a"), null, false},
+		{"Unknown configuration", true, value(tftypes.UnknownValue), null, value(tftypes.UnknownValue), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: tt.config},
+				State:  tfsdk.State{Raw: tt.state},
+				Plan:   tfsdk.Plan{Raw: tt.plan},
+			}
+			assert.Equal(t, tt.expected, planToValidate(context.Background(), tt.validateOnPlan, req))
+		})
+	}
+}
+
+func TestValidatePlannedResource(t *testing.T) {
+	type model struct {
+		Name types.String `tfsdk:"name"`
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "a")})
+	resourceSchema := schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}}
+
+	mapped := func(_ context.Context, data *model) (string, error) { return data.Name.ValueString(), nil }
+	unmapped := func(context.Context, *model) (string, error) { return "", errors.New("unknown value") }
+	rejected := func(name string) diag.Diagnostics {
+		var diags diag.Diagnostics
+		diags.AddError("Rejected", name)
+		return diags
+	}
+	putPath := func(string) (string, error) {
+		t.Fatal("dry-run apply should not run with validate_on_plan disabled")
+		return "", nil
+	}
+
+	tests := []struct {
+		name      string
+		toConsole func(context.Context, *model) (string, error)
+		checks    []func(string) diag.Diagnostics
+		expected  diag.Diagnostics
+	}{
+		{"No checks", mapped, nil, nil},
+		{"Rejected by check", mapped, []func(string) diag.Diagnostics{rejected}, rejected("a")},
+		{"Not mapped yet", unmapped, []func(string) diag.Diagnostics{rejected}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: value, Schema: resourceSchema},
+				State:  tfsdk.State{Raw: tftypes.NewValue(objectType, nil), Schema: resourceSchema},
+				Plan:   tfsdk.Plan{Raw: value, Schema: resourceSchema},
+			}
+			resp := &resource.ModifyPlanResponse{}
+			validatePlannedResource(context.Background(), req, resp, nil, false, "test", tt.toConsole, putPath, tt.checks...)
+			assert.Equal(t, tt.expected, resp.Diagnostics)
+		})
+	}
+}
@@ -2,18 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
 	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/provider_conduktor"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/mod/semver"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +27,7 @@ import (
 // Ensure ConduktorProvider satisfies various provider interfaces.
 var _ provider.Provider = &ConduktorProvider{}
 var _ provider.ProviderWithFunctions = &ConduktorProvider{}
+var _ provider.ProviderWithEphemeralResources = &ConduktorProvider{}
 
 // Mutex to make resource operations sequential.
 var resourceMutex sync.Mutex
@@ -38,9 +44,30 @@ type ConduktorProvider struct {
 	date string
 }
 
+// ProviderData holds the API clients configured by the provider, for resources and data sources to pick the one
+// of their own mode.
 type ProviderData struct {
-	Mode   client.Mode
-	Client *client.Client
+	// ConsoleClient is nil if the provider is not configured for Console.
+	ConsoleClient *client.Client
+	// GatewayClient is nil if the provider is not configured for Gateway.
+	GatewayClient *client.Client
+	// ValidateOnPlan enables the dry-run apply of the planned Console resources.
+	ValidateOnPlan bool
+	// EvaluateResourcePolicies enables the local evaluation of the resource policies of the planned Console resources.
+	EvaluateResourcePolicies bool
+	// EvaluateTopicPolicies enables the local evaluation of the topic policies of the planned Console topics.
+	EvaluateTopicPolicies bool
+}
+
+// ClientFor returns the API client of the given mode, nil if the provider is not configured for it.
+func (d *ProviderData) ClientFor(mode client.Mode) *client.Client {
+	switch mode {
+	case client.CONSOLE:
+		return d.ConsoleClient
+	case client.GATEWAY:
+		return d.GatewayClient
+	}
+	return nil
 }
 
 func (p *ConduktorProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,65 +81,137 @@ func (p *ConduktorProvider) Schema(ctx context.Context, req provider.SchemaReque
 
 func (p *ConduktorProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var input schema.ConduktorModel
-	var apiClient *client.Client
 	var data ProviderData
-	var err error
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &input)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	mode := strings.ToLower(schemaUtils.GetStringConfig(input.Mode, []string{"CDK_PROVIDER_MODE"}))
-
-	// Data will only contain the mode being either CONSOLE or GATEWAY
-	apiParameter, data, resp := p.PreFlightChecks(mode, input, resp)
-
+	modes := p.ConfiguredModes(input, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "mode", mode)
-	ctx = tflog.SetField(ctx, "api_token", apiParameter.ApiKey)
-	ctx = tflog.SetField(ctx, "base_url", apiParameter.BaseUrl)
-	ctx = tflog.SetField(ctx, "admin_user", apiParameter.CdkUser)
-	ctx = tflog.SetField(ctx, "admin_password", apiParameter.CdkPassword)
-	ctx = tflog.SetField(ctx, "cert", apiParameter.TLSParameters.Cert)
-	ctx = tflog.SetField(ctx, "cacert", apiParameter.TLSParameters.Cacert)
-	ctx = tflog.SetField(ctx, "key", apiParameter.TLSParameters.Key)
-	ctx = tflog.SetField(ctx, "insecure", apiParameter.TLSParameters.Insecure)
-	// Avoid leaking sensitive information in logs
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_token")
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "admin_password")
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "key")
+	apiParameters := map[client.Mode]client.ApiParameter{}
+	for _, mode := range modes {
+		apiParameters[mode] = p.PreFlightChecks(mode, input, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Debug(ctx, "Creating Conduktor client for: "+string(data.Mode))
+	for _, mode := range modes {
+		apiParameter := apiParameters[mode]
+
+		ctx := tflog.SetField(ctx, "mode", strings.ToLower(string(mode)))
+		ctx = tflog.SetField(ctx, "api_token", apiParameter.ApiKey)
+		ctx = tflog.SetField(ctx, "base_url", apiParameter.BaseUrl)
+		ctx = tflog.SetField(ctx, "admin_user", apiParameter.CdkUser)
+		ctx = tflog.SetField(ctx, "admin_password", apiParameter.CdkPassword)
+		ctx = tflog.SetField(ctx, "cert", apiParameter.TLSParameters.Cert)
+		ctx = tflog.SetField(ctx, "cacert", apiParameter.TLSParameters.Cacert)
+		ctx = tflog.SetField(ctx, "key", apiParameter.TLSParameters.Key)
+		ctx = tflog.SetField(ctx, "insecure", apiParameter.TLSParameters.Insecure)
+		ctx = tflog.SetField(ctx, "request_timeout", apiParameter.RequestTimeout.String())
+		ctx = tflog.SetField(ctx, "retry_max_attempts", apiParameter.RetryPolicy.MaxAttempts)
+		// Avoid leaking sensitive information in logs
+		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_token")
+		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "admin_password")
+		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "key")
+
+		tflog.Debug(ctx, "Creating Conduktor client for: "+string(mode))
+
+		apiClient, err := client.Make(ctx, mode, apiParameter, p.version)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not create the Conduktor "+string(mode)+" API client", err.Error())
+			return
+		}
 
-	apiClient, err = client.Make(ctx, data.Mode, apiParameter, p.version)
+		switch mode {
+		case client.CONSOLE:
+			data.ConsoleClient = apiClient
+		case client.GATEWAY:
+			data.GatewayClient = apiClient
+		}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Could not create the Conduktor "+string(data.Mode)+" API client", err.Error())
-		return
+		tflog.Info(ctx, "Configured Conduktor "+string(mode)+" client", map[string]any{"success": true})
 	}
 
-	data.Client = apiClient
-
-	tflog.Info(ctx, "Configured Conduktor "+string(data.Mode)+" client", map[string]any{"success": true})
+	data.ValidateOnPlan = schemaUtils.GetBooleanConfig(input.ValidateOnPlan, []string{"CDK_VALIDATE_ON_PLAN"}, false)
+	data.EvaluateResourcePolicies = schemaUtils.GetBooleanConfig(input.EvaluateResourcePolicies, []string{"CDK_EVALUATE_RESOURCE_POLICIES"}, false)
+	data.EvaluateTopicPolicies = schemaUtils.GetBooleanConfig(input.EvaluateTopicPolicies, []string{"CDK_EVALUATE_TOPIC_POLICIES"}, false)
 
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
+	resp.EphemeralResourceData = &data
 }
 
-func (p *ConduktorProvider) PreFlightChecks(mode string, input schema.ConduktorModel, resp *provider.ConfigureResponse) (client.ApiParameter, ProviderData, *provider.ConfigureResponse) {
-	var data ProviderData
-	var apiParameter client.ApiParameter
+// ConfiguredModes returns the modes to create a client for: the ones of the `console` and `gateway` blocks, and the
+// one of `mode` using the root connection attributes.
+func (p *ConduktorProvider) ConfiguredModes(input schema.ConduktorModel, resp *provider.ConfigureResponse) []client.Mode {
+	var modes []client.Mode
+
+	consoleBlock := schemaUtils.AttrIsSet(input.Console)
+	gatewayBlock := schemaUtils.AttrIsSet(input.Gateway)
+
+	mode := strings.ToLower(input.Mode.ValueString())
+	if !consoleBlock && !gatewayBlock {
+		// Environment variable is only a fallback of the root configuration, not to conflict with the blocks.
+		mode = strings.ToLower(schemaUtils.GetStringConfig(input.Mode, []string{"CDK_PROVIDER_MODE"}))
+	}
+
+	if mode != "" && !slices.Contains(validation.ValidProviderMode, mode) {
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Invalid provider mode",
+			fmt.Sprintf("The mode value or CDK_PROVIDER_MODE environment variable must be one of %v, got %q.", validation.ValidProviderMode, mode))
+		return nil
+	}
+
+	if (consoleBlock && mode == "console") || (gatewayBlock && mode == "gateway") {
+		details := "The provider cannot configure the " + mode + " client from both the root attributes and the " + mode + " block. \n" +
+			"Either remove the mode value to only use the blocks, or set it to the mode of the other client."
+
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Conflicting provider configuration", details)
+		return nil
+	}
+
+	if consoleBlock || mode == "console" {
+		modes = append(modes, client.CONSOLE)
+	}
+	if gatewayBlock || mode == "gateway" {
+		modes = append(modes, client.GATEWAY)
+	}
+
+	if len(modes) == 0 {
+		details := "The provider cannot create any API client as there is no client configured. \n" +
+			"Set either : \n" +
+			" - the mode value in the configuration or use the CDK_PROVIDER_MODE environment variable. \n" +
+			" - the console and/or gateway blocks in the configuration."
+
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Missing provider mode", details)
+	}
+
+	return modes
+}
+
+// PreFlightChecks loads and checks the parameters of the client of the given mode, reporting missing values on the
+// attributes of its block if set, on the root attributes otherwise.
+func (p *ConduktorProvider) PreFlightChecks(mode client.Mode, input schema.ConduktorModel, resp *provider.ConfigureResponse) client.ApiParameter {
+	apiParameter := client.LoadConfig(input, mode)
+
+	attributePath := func(name string) path.Path {
+		if mode == client.CONSOLE && schemaUtils.AttrIsSet(input.Console) {
+			return path.Root("console").AtName(name)
+		}
+		if mode == client.GATEWAY && schemaUtils.AttrIsSet(input.Gateway) {
+			return path.Root("gateway").AtName(name)
+		}
+		return path.Root(name)
+	}
 
 	switch mode {
-	case "console":
+	case client.CONSOLE:
 		{
-			data.Mode = client.CONSOLE
-			apiParameter = client.LoadConfig(input, client.CONSOLE)
-
 			if apiParameter.ApiKey == "" {
 				// We only need to check user and password if no apiToken is provided.
 				if apiParameter.CdkUser == "" || apiParameter.CdkPassword == "" {
@@ -122,17 +221,14 @@ func (p *ConduktorProvider) PreFlightChecks(mode string, input schema.ConduktorM
 						" - the admin_user and admin_password value in the configuration or use the CDK_ADMIN_EMAIL and CDK_ADMIN_PASSWORD environment variable. \n" +
 						"If either is already set, ensure the value is not empty."
 
-					resp.Diagnostics.AddAttributeError(path.Root("api_token"), "Missing API token", details)
-					resp.Diagnostics.AddAttributeError(path.Root("admin_user"), "Missing Admin email", details)
-					resp.Diagnostics.AddAttributeError(path.Root("admin_password"), "Missing Admin password", details)
+					resp.Diagnostics.AddAttributeError(attributePath("api_token"), "Missing API token", details)
+					resp.Diagnostics.AddAttributeError(attributePath("admin_user"), "Missing Admin email", details)
+					resp.Diagnostics.AddAttributeError(attributePath("admin_password"), "Missing Admin password", details)
 				}
 			}
 		}
-	case "gateway":
+	case client.GATEWAY:
 		{
-			data.Mode = client.GATEWAY
-			apiParameter = client.LoadConfig(input, client.GATEWAY)
-
 			if apiParameter.CdkUser == "" || apiParameter.CdkPassword == "" {
 				details := "The provider cannot create the Gateway API client as there is a missing or empty value for the admin user and password. \n" +
 					"Set both : \n" +
@@ -140,31 +236,43 @@ func (p *ConduktorProvider) PreFlightChecks(mode string, input schema.ConduktorM
 					" - the admin_password value in the configuration or use the CDK_GATEWAY_PASSWORD environment variable. \n" +
 					"If either is already set, ensure the value is not empty."
 
-				resp.Diagnostics.AddAttributeError(path.Root("admin_user"), "Missing Gateway Admin login", details)
-				resp.Diagnostics.AddAttributeError(path.Root("admin_password"), "Missing Gateway Admin password", details)
+				resp.Diagnostics.AddAttributeError(attributePath("admin_user"), "Missing Gateway Admin login", details)
+				resp.Diagnostics.AddAttributeError(attributePath("admin_password"), "Missing Gateway Admin password", details)
 			}
 		}
 	}
 
 	if apiParameter.BaseUrl == "" {
-		details := "The provider cannot create any API client as there is a missing or empty value for the Base URL. \n" +
+		details := "The provider cannot create the " + string(mode) + " API client as there is a missing or empty value for the Base URL. \n" +
 			"Set: \n" +
 			" - the base_url value in the configuration or use the following environment variables: CDK_BASE_URL or CDK_CONSOLE_BASE_URL for Console, CDK_GATEWAY_BASE_URL for Gateway. \n" +
 			"If either is already set, ensure the value is not empty."
 
-		resp.Diagnostics.AddAttributeError(path.Root("base_url"), "Missing Console URL", details)
+		resp.Diagnostics.AddAttributeError(attributePath("base_url"), "Missing "+string(mode)+" URL", details)
+	}
+
+	requestTimeout := schemaUtils.GetStringConfig(input.RequestTimeout, []string{"CDK_REQUEST_TIMEOUT"})
+	if requestTimeout != "" {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err != nil || timeout <= 0 {
+			details := fmt.Sprintf("The request_timeout value or CDK_REQUEST_TIMEOUT environment variable must be a positive duration like \"30s\" or \"2m\", got %q.", requestTimeout)
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request timeout", details)
+		}
+		apiParameter.RequestTimeout = timeout
 	}
 
-	return apiParameter, data, resp
+	return apiParameter
 }
 
 func (p *ConduktorProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAlertV3Resource,
 		NewApplicationV1Resource,
 		NewApplicationInstanceV1Resource,
 		NewApplicationInstancePermissionV1Resource,
 		NewApplicationGroupV1Resource,
 		NewConnectorV2Resource,
+		NewDataMaskingPolicyV1Resource,
 		NewUserV2Resource,
 		NewGroupV2Resource,
 		NewGenericResource,
@@ -177,6 +285,9 @@ func (p *ConduktorProvider) Resources(ctx context.Context) []func() resource.Res
 		NewServiceAccountV1Resource,
 		NewTopicV2Resource,
 		NewTopicPolicyV1Resource,
+		NewGatewayAliasTopicV2Resource,
+		NewGatewayConcentrationRuleV2Resource,
+		NewGatewayGroupV2Resource,
 		NewGatewayServiceAccountV2Resource,
 		NewGatewayTokenV2Resource,
 		NewGatewayInterceptorV2Resource,
@@ -185,11 +296,26 @@ func (p *ConduktorProvider) Resources(ctx context.Context) []func() resource.Res
 }
 
 func (p *ConduktorProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewConnectorStatusV2DataSource,
+		NewKafkaClusterV2DataSource,
+		NewTopicsV2DataSource,
+	}
+}
+
+func (p *ConduktorProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewGatewayTokenV2EphemeralResource,
+	}
 }
 
 func (p *ConduktorProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewEvaluateResourcePolicyFunction,
+		NewNormalizeSchemaFunction,
+		NewSchemaEqualFunction,
+		NewSchemaFormatFunction,
+	}
 }
 
 func New(version, commit, date string) func() provider.Provider {
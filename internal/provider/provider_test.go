@@ -2,10 +2,20 @@ package provider
 
 import (
 	"context"
+	"testing"
+
 	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	schemaUtils "github.com/conduktor/terraform-provider-conduktor/internal/schema"
 	schema "github.com/conduktor/terraform-provider-conduktor/internal/schema/provider_conduktor"
+	"github.com/conduktor/terraform-provider-conduktor/internal/test"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -22,6 +32,12 @@ provider "conduktor" {
 provider "conduktor" {
   mode = "gateway"
 }
+`
+	providerConfigConsoleAndGateway = `
+provider "conduktor" {
+  console {}
+  gateway {}
+}
 `
 )
 
@@ -67,3 +83,91 @@ func fetchClientVersion(mode client.Mode) (string, error) {
 
 	return version, nil
 }
+
+func TestAccProviderConsoleAndGatewayBlocks(t *testing.T) {
+	test.CheckEnterpriseEnabled(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfigConsoleAndGateway + test.TestAccTestdata(t, "provider/console_and_gateway.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("conduktor_gateway_service_account_v2.test", "name", "combined-sa"),
+					resource.TestCheckResourceAttr("conduktor_console_group_v2.test", "name", "combined-group"),
+				),
+			},
+		},
+	})
+}
+
+func TestConfiguredModes(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("CDK_PROVIDER_MODE", "gateway")
+
+	consoleBlock := schema.NewConsoleValueMust(schema.ConsoleValue{}.AttributeTypes(ctx), map[string]attr.Value{
+		"base_url":       types.StringNull(),
+		"api_token":      types.StringNull(),
+		"admin_user":     types.StringNull(),
+		"admin_password": types.StringNull(),
+		"cacert":         types.StringNull(),
+		"cert":           types.StringNull(),
+		"key":            types.StringNull(),
+		"insecure":       types.BoolNull(),
+	})
+	gatewayBlock := schema.NewGatewayValueMust(schema.GatewayValue{}.AttributeTypes(ctx), map[string]attr.Value{
+		"base_url":       types.StringNull(),
+		"admin_user":     types.StringNull(),
+		"admin_password": types.StringNull(),
+		"cacert":         types.StringNull(),
+		"cert":           types.StringNull(),
+		"key":            types.StringNull(),
+		"insecure":       types.BoolNull(),
+	})
+
+	tests := []struct {
+		name      string
+		mode      types.String
+		console   schema.ConsoleValue
+		gateway   schema.GatewayValue
+		expected  []client.Mode
+		expectErr bool
+	}{
+		{"Flat mode", types.StringValue("console"), schema.NewConsoleValueNull(), schema.NewGatewayValueNull(), []client.Mode{client.CONSOLE}, false},
+		{"Flat mode from environment", types.StringNull(), schema.NewConsoleValueNull(), schema.NewGatewayValueNull(), []client.Mode{client.GATEWAY}, false},
+		{"Both blocks", types.StringNull(), consoleBlock, gatewayBlock, []client.Mode{client.CONSOLE, client.GATEWAY}, false},
+		{"Console block ignores environment mode", types.StringNull(), consoleBlock, schema.NewGatewayValueNull(), []client.Mode{client.CONSOLE}, false},
+		{"Flat mode along with other block", types.StringValue("gateway"), consoleBlock, schema.NewGatewayValueNull(), []client.Mode{client.CONSOLE, client.GATEWAY}, false},
+		{"Flat mode conflicting with block", types.StringValue("console"), consoleBlock, schema.NewGatewayValueNull(), nil, true},
+	}
+
+	p := &ConduktorProvider{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &provider.ConfigureResponse{}
+			input := schema.ConduktorModel{Mode: tt.mode, Console: tt.console, Gateway: tt.gateway}
+			modes := p.ConfiguredModes(input, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expected, modes)
+		})
+	}
+}
+
+// Data sources reuse resource schemas, any resource schema must then be convertible so that a codegen change can't
+// break a data source schema.
+func TestDataSourceAttributesFromEveryResource(t *testing.T) {
+	ctx := context.Background()
+	p := New("test", "none", "unknown")()
+
+	for _, newResource := range p.(*ConduktorProvider).Resources(ctx) {
+		r := newResource()
+		metadataResp := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "conduktor"}, metadataResp)
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			_, err := schemaUtils.DataSourceAttributesFromResource(schemaResp.Schema.Attributes)
+			assert.NoError(t, err)
+		})
+	}
+}
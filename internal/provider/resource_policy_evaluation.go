@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/resourcepolicy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// policyOwners selects the application instances whose resource policies apply to a planned resource.
+type policyOwners func(instances []console.ApplicationInstanceConsoleResource) []*console.ApplicationInstanceConsoleResource
+
+// ownedBy selects the application instance owning the resource of the given type and name on a cluster.
+func ownedBy(cluster, resourceType, name string) policyOwners {
+	return func(instances []console.ApplicationInstanceConsoleResource) []*console.ApplicationInstanceConsoleResource {
+		if owner := console.FindOwner(instances, cluster, resourceType, name); owner != nil {
+			return []*console.ApplicationInstanceConsoleResource{owner}
+		}
+		return nil
+	}
+}
+
+// instancesOf selects all the instances of an application.
+func instancesOf(application string) policyOwners {
+	return func(instances []console.ApplicationInstanceConsoleResource) []*console.ApplicationInstanceConsoleResource {
+		var owners []*console.ApplicationInstanceConsoleResource
+		for i := range instances {
+			if instances[i].Metadata.Application == application {
+				owners = append(owners, &instances[i])
+			}
+		}
+		return owners
+	}
+}
+
+// evaluateResourcePolicies evaluates the resource policies of the application instances selected by owners against
+// the planned resource, reporting the error message of each rule not satisfied on the resource spec.
+// Policies targeting another kind, or whose conditions can't be compiled locally, are left to Console.
+func evaluateResourcePolicies(ctx context.Context, apiClient *client.Client, resource any, owners policyOwners) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	manifest, err := resourcepolicy.Manifest(resource)
+	if err != nil {
+		diags.AddError("Model Error", fmt.Sprintf("Unable to evaluate resource policies, got error: %s", err))
+		return diags
+	}
+
+	instances, err := listApplicationInstances(ctx, apiClient)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list application instances to evaluate resource policies, got error: %s", err))
+		return diags
+	}
+
+	evaluated := map[string]bool{}
+	for _, owner := range owners(instances) {
+		for _, policyName := range owner.Spec.PolicyRef {
+			if evaluated[policyName] {
+				continue
+			}
+			evaluated[policyName] = true
+
+			get, err := apiClient.Describe(ctx, fmt.Sprintf("%s/%s", resourcePolicyV1ApiPath, policyName))
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read resource policy %s, got error: %s", policyName, err))
+				continue
+			}
+			if len(get) == 0 {
+				tflog.Debug(ctx, fmt.Sprintf("Resource policy %s referenced by application instance %s not found", policyName, owner.Metadata.Name))
+				continue
+			}
+
+			var policy console.ResourcePolicyConsoleResource
+			err = jsoniter.Unmarshal(get, &policy)
+			if err != nil {
+				diags.AddError("Parsing Error", fmt.Sprintf("Unable to read resource policy %s, got error: %s", policyName, err))
+				continue
+			}
+			if policy.Spec.TargetKind != manifest["kind"] {
+				continue
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("Evaluating resource policy %s on %s", policyName, manifest["kind"]))
+			violations, err := resourcepolicy.Evaluate(&policy, manifest)
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("Unable to evaluate resource policy %s on plan, got error: %s", policyName, err))
+				continue
+			}
+			for _, violation := range violations {
+				diags.AddAttributeError(path.Root("spec"), "Resource policy violation",
+					fmt.Sprintf("Resource policy %s of application instance %s is not satisfied: %s.", violation.Policy, owner.Metadata.Name, violation.Message))
+			}
+		}
+	}
+	return diags
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SchemaEqualFunction{}
+
+func NewSchemaEqualFunction() function.Function {
+	return &SchemaEqualFunction{}
+}
+
+// SchemaEqualFunction defines the function implementation.
+type SchemaEqualFunction struct{}
+
+func (f *SchemaEqualFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schema_equal"
+}
+
+func (f *SchemaEqualFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two schemas for semantic equality",
+		MarkdownDescription: "Returns whether two Avro, Protobuf or JSON schemas are semantically equal, ignoring formatting and ordering differences that don't change their meaning. " +
+			"The format is detected from each schema, schemas of different formats are never equal and schemas of unknown format are compared as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "First schema to compare.",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "Second schema to compare.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *SchemaEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	equal, err := customtypes.SchemaEqual(a, b)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to compare schemas, got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, equal))
+}
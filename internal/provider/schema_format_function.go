@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SchemaFormatFunction{}
+
+func NewSchemaFormatFunction() function.Function {
+	return &SchemaFormatFunction{}
+}
+
+// SchemaFormatFunction defines the function implementation.
+type SchemaFormatFunction struct{}
+
+func (f *SchemaFormatFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schema_format"
+}
+
+func (f *SchemaFormatFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Detect the format of a schema",
+		MarkdownDescription: "Returns the format of a schema detected from its content: `AVRO`, `PROTOBUF`, `JSON` or `UNKNOWN` if none of them can parse it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "Schema to detect the format of.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SchemaFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schema string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schema))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, customtypes.DetectSchemaFormat(schema)))
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const avroSchema = `{"type":"record","name":"User","fields":[{"name":"id","type":"string"}]}`
+const avroSchemaFormatted = `{
+  "type": "record",
+  "name": "User",
+  "fields": [
+    { "name": "id", "type": "string" }
+  ]
+}`
+const protobufSchema = `syntax = "proto3";
+message User {
+  string id = 1;
+}`
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	req := function.RunRequest{Arguments: function.NewArgumentsData(arguments)}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestNormalizeSchemaFunction(t *testing.T) {
+	compact, err := runFunction(t, NewNormalizeSchemaFunction(), types.StringUnknown(), types.StringValue(avroSchema))
+	assert.Nil(t, err)
+	formatted, err := runFunction(t, NewNormalizeSchemaFunction(), types.StringUnknown(), types.StringValue(avroSchemaFormatted))
+	assert.Nil(t, err)
+	assert.Equal(t, compact, formatted)
+
+	unknown, err := runFunction(t, NewNormalizeSchemaFunction(), types.StringUnknown(), types.StringValue("not a schema"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("not a schema"), unknown)
+}
+
+func TestSchemaFormatFunction(t *testing.T) {
+	tests := []struct {
+		schema   string
+		expected string
+	}{
+		{avroSchema, "AVRO"},
+		{protobufSchema, "PROTOBUF"},
+		{`{"$schema":"http://json-schema.org/draft-07/schema#","type":"object"}`, "JSON"},
+		{"not a schema", "UNKNOWN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			format, err := runFunction(t, NewSchemaFormatFunction(), types.StringUnknown(), types.StringValue(tt.schema))
+			assert.Nil(t, err)
+			assert.Equal(t, types.StringValue(tt.expected), format)
+		})
+	}
+}
+
+func TestSchemaEqualFunction(t *testing.T) {
+	equal, err := runFunction(t, NewSchemaEqualFunction(), types.BoolUnknown(), types.StringValue(avroSchema), types.StringValue(avroSchemaFormatted))
+	assert.Nil(t, err)
+	assert.Equal(t, types.BoolValue(true), equal)
+
+	equal, err = runFunction(t, NewSchemaEqualFunction(), types.BoolUnknown(), types.StringValue(avroSchema), types.StringValue(protobufSchema))
+	assert.Nil(t, err)
+	assert.Equal(t, types.BoolValue(false), equal)
+
+	equal, err = runFunction(t, NewSchemaEqualFunction(), types.BoolUnknown(), types.StringValue(avroSchema), types.StringValue("not a schema"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.BoolValue(false), equal)
+
+	equal, err = runFunction(t, NewSchemaEqualFunction(), types.BoolUnknown(), types.StringValue("not a schema"), types.StringValue("not a schema"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.BoolValue(true), equal)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default duration of each resource operation when not set in the timeouts block.
+const defaultOperationTimeout = 20 * time.Minute
+
+// withTimeouts adds the create, read, update and delete timeouts block to a generated resource schema.
+// The resource model must then embed the generated model along with a `timeouts.Value` field.
+func withTimeouts(ctx context.Context, s schema.Schema) schema.Schema {
+	if s.Blocks == nil {
+		s.Blocks = map[string]schema.Block{}
+	}
+	s.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	return s
+}
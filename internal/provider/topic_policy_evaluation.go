@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/conduktor/terraform-provider-conduktor/internal/client"
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/conduktor/terraform-provider-conduktor/internal/topicpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// evaluateTopicPolicies evaluates the topic policies of the application instance owning the planned topic, reporting
+// each violation as an error on the topic attribute it applies to. Topics without owner are not evaluated.
+func evaluateTopicPolicies(ctx context.Context, apiClient *client.Client, topic *console.TopicConsoleResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instances, err := listApplicationInstances(ctx, apiClient)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list application instances to evaluate topic policies, got error: %s", err))
+		return diags
+	}
+
+	owner := console.FindOwner(instances, topic.Metadata.Cluster, "TOPIC", topic.Metadata.Name)
+	if owner == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Topic %s is not owned by any application instance, no topic policy to evaluate", topic.Metadata.Name))
+		return diags
+	}
+
+	for _, policyName := range owner.Spec.TopicPolicyRef {
+		get, err := apiClient.Describe(ctx, fmt.Sprintf("%s/%s", topicPolicyV1ApiPath, policyName))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read topic policy %s, got error: %s", policyName, err))
+			continue
+		}
+		if len(get) == 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Topic policy %s referenced by application instance %s not found", policyName, owner.Metadata.Name))
+			continue
+		}
+
+		var policy console.TopicPolicyResource
+		err = jsoniter.Unmarshal(get, &policy)
+		if err != nil {
+			diags.AddError("Parsing Error", fmt.Sprintf("Unable to read topic policy %s, got error: %s", policyName, err))
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Evaluating topic policy %s on topic %s", policyName, topic.Metadata.Name))
+		for _, violation := range topicpolicy.Evaluate(&policy, topic) {
+			diags.AddAttributeError(topicPolicyAttributePath(violation.Path), "Topic policy violation",
+				fmt.Sprintf("Topic policy %s of application instance %s is not satisfied by `%s`: %s.", violation.Policy, owner.Metadata.Name, violation.Path, violation.Message))
+		}
+	}
+	return diags
+}
+
+// listApplicationInstances returns all the application instances, to find the one owning a planned resource.
+func listApplicationInstances(ctx context.Context, apiClient *client.Client) ([]console.ApplicationInstanceConsoleResource, error) {
+	get, err := apiClient.Describe(ctx, applicationInstanceV1ApiPath)
+	if err != nil {
+		return nil, err
+	}
+	var instances []console.ApplicationInstanceConsoleResource
+	if len(get) > 0 {
+		err = jsoniter.Unmarshal(get, &instances)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return instances, nil
+}
+
+// topicPolicyAttributePath returns the path of the topic resource attribute a topic policy path applies to.
+func topicPolicyAttributePath(policyPath string) path.Path {
+	if key, ok := strings.CutPrefix(policyPath, "spec.configs."); ok {
+		return path.Root("spec").AtName("configs").AtMapKey(key)
+	}
+	if key, ok := strings.CutPrefix(policyPath, "metadata.labels."); ok {
+		return path.Root("labels").AtMapKey(key)
+	}
+	switch policyPath {
+	case "metadata.name":
+		return path.Root("name")
+	case "metadata.description":
+		return path.Root("description")
+	case "spec.partitions":
+		return path.Root("spec").AtName("partitions")
+	case "spec.replicationFactor":
+		return path.Root("spec").AtName("replication_factor")
+	case "spec.configs":
+		return path.Root("spec").AtName("configs")
+	case "metadata.labels":
+		return path.Root("labels")
+	}
+	return path.Root("spec")
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicPolicyAttributePath(t *testing.T) {
+	tests := []struct {
+		policyPath string
+		expected   path.Path
+	}{
+		{"spec.configs.retention.ms", path.Root("spec").AtName("configs").AtMapKey("retention.ms")},
+		{"spec.configs", path.Root("spec").AtName("configs")},
+		{"spec.partitions", path.Root("spec").AtName("partitions")},
+		{"spec.replicationFactor", path.Root("spec").AtName("replication_factor")},
+		{"metadata.name", path.Root("name")},
+		{"metadata.labels.data-criticality", path.Root("labels").AtMapKey("data-criticality")},
+		{"metadata.unknown", path.Root("spec")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policyPath, func(t *testing.T) {
+			assert.Equal(t, tt.expected, topicPolicyAttributePath(tt.policyPath))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlySecret describes a write-only variant of a secret attribute of a generated resource schema.
+type writeOnlySecret struct {
+	name        string
+	description string
+	// Whether the secret is a map of strings, like Kafka properties, instead of a single string.
+	isMap bool
+}
+
+// withWriteOnlySecrets adds the given write-only secret attributes to a generated resource schema, each paired with a
+// `<name>_version` attribute to change for the secret to be sent again. Write-only values are sent on create and
+// update but never stored in plan nor state, they require Terraform 1.11 or later.
+// The resource model must then embed a struct with a field for each of these attributes.
+func withWriteOnlySecrets(s schema.Schema, secrets ...writeOnlySecret) schema.Schema {
+	for _, secret := range secrets {
+		versionName := secret.name + "_version"
+		description := fmt.Sprintf("%s Never stored in state, requires Terraform 1.11 or later. Must be set along with `%s`.", secret.description, versionName)
+		if secret.isMap {
+			s.Attributes[secret.name] = schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         description,
+				MarkdownDescription: description,
+				Validators:          []validator.Map{mapvalidator.AlsoRequires(path.MatchRoot(versionName))},
+			}
+		} else {
+			s.Attributes[secret.name] = schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         description,
+				MarkdownDescription: description,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot(versionName))},
+			}
+		}
+
+		versionDescription := fmt.Sprintf("Version of `%s`, to change for its value to be sent again on the next apply. Changes of the secret made outside of Terraform are ignored while it is set.", secret.name)
+		s.Attributes[versionName] = schema.Int64Attribute{
+			Optional:            true,
+			Description:         versionDescription,
+			MarkdownDescription: versionDescription,
+		}
+	}
+	return s
+}
+
+// Write-only variants of the security secrets shared by the kafka connect and ksqlDB cluster resources.
+var securityWriteOnlySecrets = []writeOnlySecret{
+	{name: "security_password_wo", description: "Basic auth password, write-only variant of `spec.security.basic_auth.password`."},
+	{name: "security_token_wo", description: "Bearer token, write-only variant of `spec.security.bearer_token.token`."},
+	{name: "security_ssl_key_wo", description: "SSL auth key, write-only variant of `spec.security.ssl_auth.key`."},
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestWriteOnlySecretsSchema(t *testing.T) {
+	ctx := context.Background()
+
+	for _, r := range []resource.Resource{NewKafkaClusterV2Resource(), NewKafkaConnectV2Resource(), NewKsqlDBClusterV2Resource()} {
+		resp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected schema error: %v", resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("invalid schema implementation: %v", diags)
+		}
+
+		for name, attribute := range resp.Schema.Attributes {
+			if !attribute.IsWriteOnly() {
+				continue
+			}
+			if !attribute.IsSensitive() {
+				t.Errorf("expected write-only attribute %s to be sensitive", name)
+			}
+			if _, ok := resp.Schema.Attributes[name+"_version"]; !ok {
+				t.Errorf("expected write-only attribute %s to have a version attribute", name)
+			}
+		}
+	}
+}
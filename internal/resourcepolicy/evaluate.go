@@ -0,0 +1,129 @@
+// Package resourcepolicy compiles and evaluates the CEL conditions of Console resource policies against resource
+// manifests, the same way Console does when a resource owned by an application instance is applied.
+package resourcepolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// Violation is a rule of a resource policy whose condition is not satisfied by a resource.
+type Violation struct {
+	// Name of the resource policy declaring the rule.
+	Policy    string
+	Condition string
+	// Error message of the rule, along with the evaluation error if the condition can't be evaluated.
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s (resource policy %s)", v.Message, v.Policy)
+}
+
+// Variables of the manifest a condition can reference.
+var manifestVariables = []string{"apiVersion", "kind", "metadata", "spec"}
+
+var env = newEnv()
+
+func newEnv() *cel.Env {
+	options := []cel.EnvOption{ext.Strings(), cel.CrossTypeNumericComparisons(true)}
+	for _, variable := range manifestVariables {
+		options = append(options, cel.Variable(variable, cel.DynType))
+	}
+	e, err := cel.NewEnv(options...)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create CEL environment: %s", err))
+	}
+	return e
+}
+
+// Compile parses and checks a rule condition, returning the program to evaluate it.
+func Compile(condition string) (cel.Program, error) {
+	ast, issues := env.Compile(condition)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsAssignableType(cel.BoolType) {
+		return nil, fmt.Errorf("condition must evaluate to a bool, got %s", ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+// Evaluate returns the violations of the rules of a resource policy by the given manifest, in the order of the rules.
+// The manifest must be of the policy target kind, an error is returned if it isn't or if a condition doesn't compile.
+func Evaluate(policy *console.ResourcePolicyConsoleResource, manifest map[string]any) ([]Violation, error) {
+	if kind, ok := manifest["kind"].(string); ok && policy.Spec.TargetKind != "" && kind != policy.Spec.TargetKind {
+		return nil, fmt.Errorf("resource policy %s targets kind %s, got %s", policy.Metadata.Name, policy.Spec.TargetKind, kind)
+	}
+
+	activation := make(map[string]any, len(manifestVariables))
+	for _, variable := range manifestVariables {
+		if value, ok := manifest[variable]; ok {
+			activation[variable] = value
+		} else {
+			// Missing parts are empty, for conditions on optional fields not to fail on undeclared variables.
+			activation[variable] = map[string]any{}
+		}
+	}
+
+	var violations []Violation
+	for _, rule := range policy.Spec.Rules {
+		program, err := Compile(rule.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition %q of resource policy %s: %w", rule.Condition, policy.Metadata.Name, err)
+		}
+
+		violation := Violation{Policy: policy.Metadata.Name, Condition: rule.Condition, Message: rule.ErrorMessage}
+		result, _, err := program.Eval(activation)
+		if err != nil {
+			violation.Message = fmt.Sprintf("%s (condition can't be evaluated: %s)", rule.ErrorMessage, err)
+			violations = append(violations, violation)
+			continue
+		}
+		if satisfied, ok := result.Value().(bool); !ok || !satisfied {
+			violations = append(violations, violation)
+		}
+	}
+	return violations, nil
+}
+
+// Manifest returns the JSON manifest of a Console resource as sent to the API, with whole numbers kept as integers
+// for conditions to compare them with integer literals.
+func Manifest(resource any) (map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var manifest map[string]any
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, err
+	}
+	return normalizeNumbers(manifest).(map[string]any), nil
+}
+
+func normalizeNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeNumbers(item)
+		}
+	}
+	return value
+}
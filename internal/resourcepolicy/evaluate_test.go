@@ -0,0 +1,122 @@
+package resourcepolicy
+
+import (
+	"testing"
+
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func topicManifest(t *testing.T) map[string]any {
+	topic := console.NewTopicConsoleResource(
+		console.TopicConsoleMetadata{
+			Name:    "click.events.avro",
+			Cluster: "kafka-cluster",
+			Labels:  map[string]string{"data-criticality": "C1"},
+		},
+		console.TopicConsoleSpec{
+			Partitions:        3,
+			ReplicationFactor: 3,
+			Configs:           map[string]string{"retention.ms": "86400000"},
+		},
+	)
+	manifest, err := Manifest(&topic)
+	require.NoError(t, err)
+	return manifest
+}
+
+func newPolicy(targetKind string, rules ...console.ResourcePolicyConsoleRule) *console.ResourcePolicyConsoleResource {
+	policy := console.NewResourcePolicyConsoleResource(
+		console.ResourcePolicyConsoleMetadata{Name: "policy"},
+		console.ResourcePolicyConsoleSpec{TargetKind: targetKind, Rules: rules},
+	)
+	return &policy
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		err       string
+	}{
+		{name: "match", condition: `metadata.name.matches("^click\\.[a-z0-9-]+\\.(avro|json)$")`},
+		{name: "in list", condition: `metadata.labels["data-criticality"] in ["C0", "C1", "C2"]`},
+		{name: "conversions", condition: `int(string(spec.configs["retention.ms"])) >= 60000`},
+		{name: "string extension", condition: `metadata.name.lowerAscii() == metadata.name`},
+		{name: "syntax error", condition: `metadata.name ==`, err: "Syntax error"},
+		{name: "undeclared reference", condition: `metdata.name == "a"`, err: "undeclared reference to 'metdata'"},
+		{name: "not a bool", condition: `size(metadata.name)`, err: "condition must evaluate to a bool, got int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.condition)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []console.ResourcePolicyConsoleRule
+		expected []Violation
+	}{
+		{
+			name: "all rules satisfied",
+			rules: []console.ResourcePolicyConsoleRule{
+				{Condition: `metadata.name.matches("^click\\.[a-z0-9-]+\\.(avro|json)$")`, ErrorMessage: "bad name"},
+				{Condition: `metadata.labels["data-criticality"] in ["C0", "C1", "C2"]`, ErrorMessage: "bad criticality"},
+				{Condition: `spec.partitions >= 3 && spec.replicationFactor == 3`, ErrorMessage: "bad sizing"},
+			},
+		},
+		{
+			name: "rule not satisfied",
+			rules: []console.ResourcePolicyConsoleRule{
+				{Condition: `int(string(spec.configs["retention.ms"])) <= 3600000`, ErrorMessage: "retention should be between 1m and 1h"},
+				{Condition: `spec.partitions > 6`, ErrorMessage: "at least 6 partitions"},
+			},
+			expected: []Violation{
+				{Policy: "policy", Condition: `int(string(spec.configs["retention.ms"])) <= 3600000`, Message: "retention should be between 1m and 1h"},
+				{Policy: "policy", Condition: `spec.partitions > 6`, Message: "at least 6 partitions"},
+			},
+		},
+		{
+			name: "condition that can't be evaluated",
+			rules: []console.ResourcePolicyConsoleRule{
+				{Condition: `metadata.labels["owner"] == "team-a"`, ErrorMessage: "owner label is required"},
+			},
+			expected: []Violation{
+				{Policy: "policy", Condition: `metadata.labels["owner"] == "team-a"`, Message: "owner label is required (condition can't be evaluated: no such key: owner)"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := Evaluate(newPolicy("Topic", tt.rules...), topicManifest(t))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, violations)
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	_, err := Evaluate(newPolicy("Connector", console.ResourcePolicyConsoleRule{Condition: "true"}), topicManifest(t))
+	assert.ErrorContains(t, err, "resource policy policy targets kind Connector, got Topic")
+
+	_, err = Evaluate(newPolicy("Topic", console.ResourcePolicyConsoleRule{Condition: "spec.partitions >"}), topicManifest(t))
+	assert.ErrorContains(t, err, `invalid condition "spec.partitions >" of resource policy policy`)
+}
+
+func TestManifest(t *testing.T) {
+	manifest := topicManifest(t)
+	assert.Equal(t, "Topic", manifest["kind"])
+	assert.Equal(t, int64(3), manifest["spec"].(map[string]any)["partitions"])
+	assert.Equal(t, "86400000", manifest["spec"].(map[string]any)["configs"].(map[string]any)["retention.ms"])
+}
@@ -0,0 +1,183 @@
+package schema
+
+import (
+	"fmt"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DataSourceAttributesFromResource converts generated resource attributes into read-only data source attributes.
+// Every attribute becomes computed except the ones listed in required, which are the lookup keys of the data source.
+// Custom types are kept so the resource model and mapper can be reused as is, while defaults, plan modifiers
+// and validators are dropped as they only make sense on user provided values.
+// An error is returned if an attribute type can't be converted.
+func DataSourceAttributesFromResource(attributes map[string]rschema.Attribute, required ...string) (map[string]dschema.Attribute, error) {
+	requiredSet := make(map[string]bool, len(required))
+	for _, name := range required {
+		requiredSet[name] = true
+	}
+
+	result := make(map[string]dschema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		converted, err := dataSourceAttributeFromResource(attribute, requiredSet[name])
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		result[name] = converted
+	}
+	return result, nil
+}
+
+func dataSourceAttributeFromResource(attribute rschema.Attribute, required bool) (dschema.Attribute, error) {
+	computed := !required
+	switch a := attribute.(type) {
+	case rschema.StringAttribute:
+		return dschema.StringAttribute{
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.BoolAttribute:
+		return dschema.BoolAttribute{
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.Int64Attribute:
+		return dschema.Int64Attribute{
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.Float64Attribute:
+		return dschema.Float64Attribute{
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.MapAttribute:
+		return dschema.MapAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.ListAttribute:
+		return dschema.ListAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.SetAttribute:
+		return dschema.SetAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.SingleNestedAttribute:
+		nested, err := DataSourceAttributesFromResource(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.SingleNestedAttribute{
+			Attributes:          nested,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.ListNestedAttribute:
+		nestedObject, err := dataSourceNestedObjectFromResource(a.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.ListNestedAttribute{
+			NestedObject:        nestedObject,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.SetNestedAttribute:
+		nestedObject, err := dataSourceNestedObjectFromResource(a.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.SetNestedAttribute{
+			NestedObject:        nestedObject,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	case rschema.MapNestedAttribute:
+		nestedObject, err := dataSourceNestedObjectFromResource(a.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		return dschema.MapNestedAttribute{
+			NestedObject:        nestedObject,
+			CustomType:          a.CustomType,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			DeprecationMessage:  a.DeprecationMessage,
+		}, nil
+	default:
+		// Only reachable if a generated resource schema starts using a new attribute type.
+		return nil, fmt.Errorf("unsupported resource attribute type %T for data source conversion", attribute)
+	}
+}
+
+func dataSourceNestedObjectFromResource(object rschema.NestedAttributeObject) (dschema.NestedAttributeObject, error) {
+	attributes, err := DataSourceAttributesFromResource(object.Attributes)
+	if err != nil {
+		return dschema.NestedAttributeObject{}, err
+	}
+	return dschema.NestedAttributeObject{
+		Attributes: attributes,
+		CustomType: object.CustomType,
+	}, nil
+}
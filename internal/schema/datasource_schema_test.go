@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	kafkacluster "github.com/conduktor/terraform-provider-conduktor/internal/schema/resource_console_kafka_cluster_v2"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceAttributesFromResource(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := kafkacluster.ConsoleKafkaClusterV2ResourceSchema(ctx)
+
+	attributes, err := DataSourceAttributesFromResource(resourceSchema.Attributes, "name")
+	require.NoError(t, err)
+	assert.Len(t, attributes, len(resourceSchema.Attributes))
+
+	name, ok := attributes["name"].(dschema.StringAttribute)
+	assert.True(t, ok)
+	assert.True(t, name.Required)
+	assert.False(t, name.Computed)
+
+	labels, ok := attributes["labels"].(dschema.MapAttribute)
+	assert.True(t, ok)
+	assert.True(t, labels.Computed)
+	assert.False(t, labels.Optional)
+
+	spec, ok := attributes["spec"].(dschema.SingleNestedAttribute)
+	assert.True(t, ok)
+	assert.True(t, spec.Computed)
+	assert.False(t, spec.Required)
+	// Custom types are kept so the generated resource model can be reused.
+	assert.Equal(t, kafkacluster.SpecType{}.String(), spec.CustomType.String())
+
+	flavor := spec.Attributes["kafka_flavor"].(dschema.SingleNestedAttribute)
+	secret := flavor.Attributes["confluent"].(dschema.SingleNestedAttribute).Attributes["secret"].(dschema.StringAttribute)
+	assert.True(t, secret.Computed)
+	assert.False(t, secret.Required)
+	assert.True(t, secret.Sensitive)
+
+	dataSourceSchema := dschema.Schema{Attributes: attributes}
+	assert.False(t, dataSourceSchema.ValidateImplementation(ctx).HasError())
+}
+
+func TestDataSourceAttributesFromResourceUnsupportedType(t *testing.T) {
+	attributes := map[string]rschema.Attribute{
+		"spec": rschema.SingleNestedAttribute{
+			Attributes: map[string]rschema.Attribute{"value": rschema.DynamicAttribute{Optional: true}},
+			Optional:   true,
+		},
+	}
+
+	_, err := DataSourceAttributesFromResource(attributes)
+	assert.ErrorContains(t, err, "attribute spec: attribute value: unsupported resource attribute type schema.DynamicAttribute")
+}
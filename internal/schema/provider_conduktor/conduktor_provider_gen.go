@@ -4,10 +4,18 @@ package provider_conduktor
 
 import (
 	"context"
+	"fmt"
 	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 )
@@ -47,6 +55,16 @@ func ConduktorProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "Cert in PEM format to authenticate using client certificates. May be set using environment variable `CDK_CONSOLE_CERT` or `CDK_CERT` for Console, `CDK_GATEWAY_CERT` or `CDK_CERT` for Gateway. Must be used with key. If key is provided, cert is required. Useful when Console is behind a reverse proxy with client certificate authentication.",
 				MarkdownDescription: "Cert in PEM format to authenticate using client certificates. May be set using environment variable `CDK_CONSOLE_CERT` or `CDK_CERT` for Console, `CDK_GATEWAY_CERT` or `CDK_CERT` for Gateway. Must be used with key. If key is provided, cert is required. Useful when Console is behind a reverse proxy with client certificate authentication.",
 			},
+			"evaluate_resource_policies": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Evaluate during plan the CEL rules of the resource policies of the application instances owning each created or updated `conduktor_console_topic_v2`, `conduktor_console_connector_v2`, `conduktor_console_kafka_subject_v2` and `conduktor_console_application_group_v1`, without sending the resource to Console. The error message of each rule not satisfied is reported on the resource `spec`. Each evaluated resource costs one API request per plan to list application instances, plus one per resource policy. May be set using environment variable `CDK_EVALUATE_RESOURCE_POLICIES`. Defaults to `false`.",
+				MarkdownDescription: "Evaluate during plan the CEL rules of the resource policies of the application instances owning each created or updated `conduktor_console_topic_v2`, `conduktor_console_connector_v2`, `conduktor_console_kafka_subject_v2` and `conduktor_console_application_group_v1`, without sending the resource to Console. The error message of each rule not satisfied is reported on the resource `spec`. Each evaluated resource costs one API request per plan to list application instances, plus one per resource policy. May be set using environment variable `CDK_EVALUATE_RESOURCE_POLICIES`. Defaults to `false`.",
+			},
+			"evaluate_topic_policies": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Evaluate during plan the topic policies of the application instance owning each created or updated `conduktor_console_topic_v2`, without sending the topic to Console. Violations are reported on the offending topic attribute, like a `spec.configs` key. Each evaluated topic costs one API request per plan to list application instances, plus one per topic policy. May be set using environment variable `CDK_EVALUATE_TOPIC_POLICIES`. Defaults to `false`.",
+				MarkdownDescription: "Evaluate during plan the topic policies of the application instance owning each created or updated `conduktor_console_topic_v2`, without sending the topic to Console. Violations are reported on the offending topic attribute, like a `spec.configs` key. Each evaluated topic costs one API request per plan to list application instances, plus one per topic policy. May be set using environment variable `CDK_EVALUATE_TOPIC_POLICIES`. Defaults to `false`.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:            true,
 				Description:         "Skip TLS verification flag. May be set using environment variable `CDK_CONSOLE_INSECURE` or `CDK_INSECURE` for Console, `CDK_GATEWAY_INSECURE` or `CDK_INSECURE` for Gateway.",
@@ -57,26 +75,2145 @@ func ConduktorProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "Key in PEM format to authenticate using client certificates. May be set using environment variable `CDK_CONSOLE_KEY` or `CDK_KEY` for Console, `CDK_GATEWAY_KEY` or `CDK_KEY` for Gateway. Must be used with cert. If cert is provided, key is required. Useful when Console is behind a reverse proxy with client certificate authentication.",
 				MarkdownDescription: "Key in PEM format to authenticate using client certificates. May be set using environment variable `CDK_CONSOLE_KEY` or `CDK_KEY` for Console, `CDK_GATEWAY_KEY` or `CDK_KEY` for Gateway. Must be used with cert. If cert is provided, key is required. Useful when Console is behind a reverse proxy with client certificate authentication.",
 			},
+			"log_redact_patterns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Additional regular expressions of the JSON keys whose value is masked in the request and response bodies logged at `TRACE` level, matched case-insensitively on any key including Kafka properties and connector configs. They extend the default patterns matching `password`, `secret`, `token`, `sasl.jaas.config` and private keys, along with the known credentials of Console and Gateway resources. May be set using environment variable `CDK_LOG_REDACT_PATTERNS` as a comma separated list.",
+				MarkdownDescription: "Additional regular expressions of the JSON keys whose value is masked in the request and response bodies logged at `TRACE` level, matched case-insensitively on any key including Kafka properties and connector configs. They extend the default patterns matching `password`, `secret`, `token`, `sasl.jaas.config` and private keys, along with the known credentials of Console and Gateway resources. May be set using environment variable `CDK_LOG_REDACT_PATTERNS` as a comma separated list.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validation.Regex()),
+				},
+			},
 			"mode": schema.StringAttribute{
-				Required:            true,
-				Description:         "The mode for the Terraform provider. When using one provider, can be set to either `console` or `gateway`. Alternatively for multi-provider configuration, set both providers with an an alias, and set the relevant alias at the resource level. May also be set using environment variable `CDK_PROVIDER_MODE`.\nSee [documentation](https://github.com/conduktor/terraform-provider-conduktor/blob/main/docs/index.md#multi-client-configuration-using-terraform-alias) for more information.",
-				MarkdownDescription: "The mode for the Terraform provider. When using one provider, can be set to either `console` or `gateway`. Alternatively for multi-provider configuration, set both providers with an an alias, and set the relevant alias at the resource level. May also be set using environment variable `CDK_PROVIDER_MODE`.\nSee [documentation](https://github.com/conduktor/terraform-provider-conduktor/blob/main/docs/index.md#multi-client-configuration-using-terraform-alias) for more information.",
+				Optional:            true,
+				Description:         "The mode for the Terraform provider. When using one provider for a single API, can be set to either `console` or `gateway` along with the connection attributes at the root of the provider. To manage both Console and Gateway resources with a single provider, use the `console` and `gateway` blocks instead. Required unless a `console` or `gateway` block is set. May also be set using environment variable `CDK_PROVIDER_MODE`, only read when no `console` or `gateway` block is set.\nSee [documentation](https://github.com/conduktor/terraform-provider-conduktor/blob/main/docs/index.md#multi-client-configuration) for more information.",
+				MarkdownDescription: "The mode for the Terraform provider. When using one provider for a single API, can be set to either `console` or `gateway` along with the connection attributes at the root of the provider. To manage both Console and Gateway resources with a single provider, use the `console` and `gateway` blocks instead. Required unless a `console` or `gateway` block is set. May also be set using environment variable `CDK_PROVIDER_MODE`, only read when no `console` or `gateway` block is set.\nSee [documentation](https://github.com/conduktor/terraform-provider-conduktor/blob/main/docs/index.md#multi-client-configuration) for more information.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(validation.ValidProviderMode...),
 				},
 			},
+			"request_timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Maximum duration of a single API request to Conduktor Console or Gateway, as a duration string like `30s` or `2m`. May be set using environment variable `CDK_REQUEST_TIMEOUT`. Defaults to no timeout other than the resource operation `timeouts`.",
+				MarkdownDescription: "Maximum duration of a single API request to Conduktor Console or Gateway, as a duration string like `30s` or `2m`. May be set using environment variable `CDK_REQUEST_TIMEOUT`. Defaults to no timeout other than the resource operation `timeouts`.",
+				Validators: []validator.String{
+					validation.Duration(),
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"base_backoff": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Wait duration before the first retry, doubled on each following retry. Defaults to `1s`.",
+						MarkdownDescription: "Wait duration before the first retry, doubled on each following retry. Defaults to `1s`.",
+						Validators: []validator.String{
+							validation.Duration(),
+						},
+					},
+					"jitter": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "Randomize wait durations between attempts to spread retries of concurrent requests. Defaults to `true`.",
+						MarkdownDescription: "Randomize wait durations between attempts to spread retries of concurrent requests. Defaults to `true`.",
+					},
+					"max_attempts": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Maximum number of attempts of a request, including the first one. Set to 1 to disable retries. Defaults to 3.",
+						MarkdownDescription: "Maximum number of attempts of a request, including the first one. Set to 1 to disable retries. Defaults to 3.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Maximum wait duration between two attempts, also applied to the `Retry-After` response header. Defaults to `30s`.",
+						MarkdownDescription: "Maximum wait duration between two attempts, also applied to the `Retry-After` response header. Defaults to `30s`.",
+						Validators: []validator.String{
+							validation.Duration(),
+						},
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						Description:         "HTTP response status codes to retry. Defaults to `[429, 502, 503, 504]`.",
+						MarkdownDescription: "HTTP response status codes to retry. Defaults to `[429, 502, 503, 504]`.",
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+				},
+				CustomType: RetryType{
+					ObjectType: types.ObjectType{
+						AttrTypes: RetryValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Retry policy of API requests failing with a transient error. Only idempotent requests (GET, PUT, DELETE) are retried. If not set, requests are retried up to 3 attempts with an exponential backoff from `1s` to `30s` with jitter on status codes 429, 502, 503 and 504, and on network errors.",
+				MarkdownDescription: "Retry policy of API requests failing with a transient error. Only idempotent requests (GET, PUT, DELETE) are retried. If not set, requests are retried up to 3 attempts with an exponential backoff from `1s` to `30s` with jitter on status codes 429, 502, 503 and 504, and on network errors.",
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Validate the created or updated Console resources during plan, by sending them to the Console apply endpoint in dry-run mode. Rejections by resource policies, topic policies or self-service ownership rules are then reported by the plan instead of failing the apply. Each validated resource costs one API request per plan. May be set using environment variable `CDK_VALIDATE_ON_PLAN`. Defaults to `false`.",
+				MarkdownDescription: "Validate the created or updated Console resources during plan, by sending them to the Console apply endpoint in dry-run mode. Rejections by resource policies, topic policies or self-service ownership rules are then reported by the plan instead of failing the apply. Each validated resource costs one API request per plan. May be set using environment variable `CDK_VALIDATE_ON_PLAN`. Defaults to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"console": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"admin_password": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "The password of the Console admin user. May be set using environment variable `CDK_CONSOLE_PASSWORD` or `CDK_ADMIN_PASSWORD`. Required if admin_user is set.",
+						MarkdownDescription: "The password of the Console admin user. May be set using environment variable `CDK_CONSOLE_PASSWORD` or `CDK_ADMIN_PASSWORD`. Required if admin_user is set.",
+					},
+					"admin_user": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The login of the Console admin user. May be set using environment variable `CDK_CONSOLE_USER`, `CDK_ADMIN_EMAIL` or `CDK_ADMIN_USER`. Required if admin_password is set.",
+						MarkdownDescription: "The login of the Console admin user. May be set using environment variable `CDK_CONSOLE_USER`, `CDK_ADMIN_EMAIL` or `CDK_ADMIN_USER`. Required if admin_password is set.",
+					},
+					"api_token": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "The API token to authenticate with the Conduktor Console API. May be set using environment variable `CDK_API_TOKEN` or `CDK_API_KEY`. If not provided, admin_user and admin_password will be used to authenticate.",
+						MarkdownDescription: "The API token to authenticate with the Conduktor Console API. May be set using environment variable `CDK_API_TOKEN` or `CDK_API_KEY`. If not provided, admin_user and admin_password will be used to authenticate.",
+					},
+					"base_url": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The URL of Conduktor Console. May be set using environment variable `CDK_CONSOLE_BASE_URL` or `CDK_BASE_URL`. Required either here or in the environment.",
+						MarkdownDescription: "The URL of Conduktor Console. May be set using environment variable `CDK_CONSOLE_BASE_URL` or `CDK_BASE_URL`. Required either here or in the environment.",
+					},
+					"cacert": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Root CA certificate in PEM format to verify the Console certificate. May be set using environment variable `CDK_CONSOLE_CACERT` or `CDK_CACERT`. If not provided, the system's root CA certificates will be used.",
+						MarkdownDescription: "Root CA certificate in PEM format to verify the Console certificate. May be set using environment variable `CDK_CONSOLE_CACERT` or `CDK_CACERT`. If not provided, the system's root CA certificates will be used.",
+					},
+					"cert": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Cert in PEM format to authenticate to Console using client certificates. May be set using environment variable `CDK_CONSOLE_CERT` or `CDK_CERT`. Must be used with key.",
+						MarkdownDescription: "Cert in PEM format to authenticate to Console using client certificates. May be set using environment variable `CDK_CONSOLE_CERT` or `CDK_CERT`. Must be used with key.",
+					},
+					"insecure": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "Skip TLS verification flag. May be set using environment variable `CDK_CONSOLE_INSECURE` or `CDK_INSECURE`.",
+						MarkdownDescription: "Skip TLS verification flag. May be set using environment variable `CDK_CONSOLE_INSECURE` or `CDK_INSECURE`.",
+					},
+					"key": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Key in PEM format to authenticate to Console using client certificates. May be set using environment variable `CDK_CONSOLE_KEY` or `CDK_KEY`. Must be used with cert.",
+						MarkdownDescription: "Key in PEM format to authenticate to Console using client certificates. May be set using environment variable `CDK_CONSOLE_KEY` or `CDK_KEY`. Must be used with cert.",
+					},
+				},
+				CustomType: ConsoleType{
+					ObjectType: types.ObjectType{
+						AttrTypes: ConsoleValue{}.AttributeTypes(ctx),
+					},
+				},
+				Description:         "Connection to Conduktor Console, used by the `conduktor_console_*` and `conduktor_generic` resources. Can be set along with the `gateway` block to manage both Console and Gateway resources with a single provider. Replaces the root connection attributes and `mode`, which must then be left unset or set to `gateway`.",
+				MarkdownDescription: "Connection to Conduktor Console, used by the `conduktor_console_*` and `conduktor_generic` resources. Can be set along with the `gateway` block to manage both Console and Gateway resources with a single provider. Replaces the root connection attributes and `mode`, which must then be left unset or set to `gateway`.",
+			},
+			"gateway": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"admin_password": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "The password of the Gateway admin user. May be set using environment variable `CDK_GATEWAY_PASSWORD` or `CDK_ADMIN_PASSWORD`. Required either here or in the environment.",
+						MarkdownDescription: "The password of the Gateway admin user. May be set using environment variable `CDK_GATEWAY_PASSWORD` or `CDK_ADMIN_PASSWORD`. Required either here or in the environment.",
+					},
+					"admin_user": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The login of the Gateway admin user. May be set using environment variable `CDK_GATEWAY_USER` or `CDK_ADMIN_USER`. Required either here or in the environment.",
+						MarkdownDescription: "The login of the Gateway admin user. May be set using environment variable `CDK_GATEWAY_USER` or `CDK_ADMIN_USER`. Required either here or in the environment.",
+					},
+					"base_url": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The URL of Conduktor Gateway admin API. May be set using environment variable `CDK_GATEWAY_BASE_URL` or `CDK_BASE_URL`. Required either here or in the environment.",
+						MarkdownDescription: "The URL of Conduktor Gateway admin API. May be set using environment variable `CDK_GATEWAY_BASE_URL` or `CDK_BASE_URL`. Required either here or in the environment.",
+					},
+					"cacert": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Root CA certificate in PEM format to verify the Gateway certificate. May be set using environment variable `CDK_GATEWAY_CACERT` or `CDK_CACERT`. If not provided, the system's root CA certificates will be used.",
+						MarkdownDescription: "Root CA certificate in PEM format to verify the Gateway certificate. May be set using environment variable `CDK_GATEWAY_CACERT` or `CDK_CACERT`. If not provided, the system's root CA certificates will be used.",
+					},
+					"cert": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Cert in PEM format to authenticate to Gateway using client certificates. May be set using environment variable `CDK_GATEWAY_CERT` or `CDK_CERT`. Must be used with key.",
+						MarkdownDescription: "Cert in PEM format to authenticate to Gateway using client certificates. May be set using environment variable `CDK_GATEWAY_CERT` or `CDK_CERT`. Must be used with key.",
+					},
+					"insecure": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "Skip TLS verification flag. May be set using environment variable `CDK_GATEWAY_INSECURE` or `CDK_INSECURE`.",
+						MarkdownDescription: "Skip TLS verification flag. May be set using environment variable `CDK_GATEWAY_INSECURE` or `CDK_INSECURE`.",
+					},
+					"key": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Key in PEM format to authenticate to Gateway using client certificates. May be set using environment variable `CDK_GATEWAY_KEY` or `CDK_KEY`. Must be used with cert.",
+						MarkdownDescription: "Key in PEM format to authenticate to Gateway using client certificates. May be set using environment variable `CDK_GATEWAY_KEY` or `CDK_KEY`. Must be used with cert.",
+					},
+				},
+				CustomType: GatewayType{
+					ObjectType: types.ObjectType{
+						AttrTypes: GatewayValue{}.AttributeTypes(ctx),
+					},
+				},
+				Description:         "Connection to Conduktor Gateway, used by the `conduktor_gateway_*` resources. Can be set along with the `console` block to manage both Console and Gateway resources with a single provider. Replaces the root connection attributes and `mode`, which must then be left unset or set to `console`.",
+				MarkdownDescription: "Connection to Conduktor Gateway, used by the `conduktor_gateway_*` resources. Can be set along with the `console` block to manage both Console and Gateway resources with a single provider. Replaces the root connection attributes and `mode`, which must then be left unset or set to `console`.",
+			},
 		},
 	}
 }
 
 type ConduktorModel struct {
-	AdminPassword types.String `tfsdk:"admin_password"`
-	AdminUser     types.String `tfsdk:"admin_user"`
-	ApiToken      types.String `tfsdk:"api_token"`
-	BaseUrl       types.String `tfsdk:"base_url"`
-	Cacert        types.String `tfsdk:"cacert"`
-	Cert          types.String `tfsdk:"cert"`
-	Insecure      types.Bool   `tfsdk:"insecure"`
-	Key           types.String `tfsdk:"key"`
-	Mode          types.String `tfsdk:"mode"`
+	AdminPassword            types.String `tfsdk:"admin_password"`
+	AdminUser                types.String `tfsdk:"admin_user"`
+	ApiToken                 types.String `tfsdk:"api_token"`
+	BaseUrl                  types.String `tfsdk:"base_url"`
+	Cacert                   types.String `tfsdk:"cacert"`
+	Cert                     types.String `tfsdk:"cert"`
+	EvaluateResourcePolicies types.Bool   `tfsdk:"evaluate_resource_policies"`
+	EvaluateTopicPolicies    types.Bool   `tfsdk:"evaluate_topic_policies"`
+	Insecure                 types.Bool   `tfsdk:"insecure"`
+	Key                      types.String `tfsdk:"key"`
+	LogRedactPatterns        types.List   `tfsdk:"log_redact_patterns"`
+	Mode                     types.String `tfsdk:"mode"`
+	RequestTimeout           types.String `tfsdk:"request_timeout"`
+	Retry                    RetryValue   `tfsdk:"retry"`
+	ValidateOnPlan           types.Bool   `tfsdk:"validate_on_plan"`
+	Console                  ConsoleValue `tfsdk:"console"`
+	Gateway                  GatewayValue `tfsdk:"gateway"`
+}
+
+var _ basetypes.ObjectTypable = RetryType{}
+
+type RetryType struct {
+	basetypes.ObjectType
+}
+
+func (t RetryType) Equal(o attr.Type) bool {
+	other, ok := o.(RetryType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t RetryType) String() string {
+	return "RetryType"
+}
+
+func (t RetryType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	baseBackoffAttribute, ok := attributes["base_backoff"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_backoff is missing from object`)
+
+		return nil, diags
+	}
+
+	baseBackoffVal, ok := baseBackoffAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_backoff expected to be basetypes.StringValue, was: %T`, baseBackoffAttribute))
+	}
+
+	jitterAttribute, ok := attributes["jitter"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`jitter is missing from object`)
+
+		return nil, diags
+	}
+
+	jitterVal, ok := jitterAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`jitter expected to be basetypes.BoolValue, was: %T`, jitterAttribute))
+	}
+
+	maxAttemptsAttribute, ok := attributes["max_attempts"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_attempts is missing from object`)
+
+		return nil, diags
+	}
+
+	maxAttemptsVal, ok := maxAttemptsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_attempts expected to be basetypes.Int64Value, was: %T`, maxAttemptsAttribute))
+	}
+
+	maxBackoffAttribute, ok := attributes["max_backoff"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backoff is missing from object`)
+
+		return nil, diags
+	}
+
+	maxBackoffVal, ok := maxBackoffAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backoff expected to be basetypes.StringValue, was: %T`, maxBackoffAttribute))
+	}
+
+	retryableStatusCodesAttribute, ok := attributes["retryable_status_codes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`retryable_status_codes is missing from object`)
+
+		return nil, diags
+	}
+
+	retryableStatusCodesVal, ok := retryableStatusCodesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`retryable_status_codes expected to be basetypes.ListValue, was: %T`, retryableStatusCodesAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return RetryValue{
+		BaseBackoff:          baseBackoffVal,
+		Jitter:               jitterVal,
+		MaxAttempts:          maxAttemptsVal,
+		MaxBackoff:           maxBackoffVal,
+		RetryableStatusCodes: retryableStatusCodesVal,
+		state:                attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRetryValueNull() RetryValue {
+	return RetryValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewRetryValueUnknown() RetryValue {
+	return RetryValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewRetryValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (RetryValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing RetryValue Attribute Value",
+				"While creating a RetryValue value, a missing attribute value was detected. "+
+					"A RetryValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid RetryValue Attribute Type",
+				"While creating a RetryValue value, an invalid attribute value was detected. "+
+					"A RetryValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra RetryValue Attribute Value",
+				"While creating a RetryValue value, an extra attribute value was detected. "+
+					"A RetryValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra RetryValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewRetryValueUnknown(), diags
+	}
+
+	baseBackoffAttribute, ok := attributes["base_backoff"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_backoff is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	baseBackoffVal, ok := baseBackoffAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_backoff expected to be basetypes.StringValue, was: %T`, baseBackoffAttribute))
+	}
+
+	jitterAttribute, ok := attributes["jitter"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`jitter is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	jitterVal, ok := jitterAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`jitter expected to be basetypes.BoolValue, was: %T`, jitterAttribute))
+	}
+
+	maxAttemptsAttribute, ok := attributes["max_attempts"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_attempts is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	maxAttemptsVal, ok := maxAttemptsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_attempts expected to be basetypes.Int64Value, was: %T`, maxAttemptsAttribute))
+	}
+
+	maxBackoffAttribute, ok := attributes["max_backoff"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`max_backoff is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	maxBackoffVal, ok := maxBackoffAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`max_backoff expected to be basetypes.StringValue, was: %T`, maxBackoffAttribute))
+	}
+
+	retryableStatusCodesAttribute, ok := attributes["retryable_status_codes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`retryable_status_codes is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	retryableStatusCodesVal, ok := retryableStatusCodesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`retryable_status_codes expected to be basetypes.ListValue, was: %T`, retryableStatusCodesAttribute))
+	}
+
+	if diags.HasError() {
+		return NewRetryValueUnknown(), diags
+	}
+
+	return RetryValue{
+		BaseBackoff:          baseBackoffVal,
+		Jitter:               jitterVal,
+		MaxAttempts:          maxAttemptsVal,
+		MaxBackoff:           maxBackoffVal,
+		RetryableStatusCodes: retryableStatusCodesVal,
+		state:                attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRetryValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) RetryValue {
+	object, diags := NewRetryValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewRetryValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t RetryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewRetryValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewRetryValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewRetryValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewRetryValueMust(RetryValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t RetryType) ValueType(ctx context.Context) attr.Value {
+	return RetryValue{}
+}
+
+var _ basetypes.ObjectValuable = RetryValue{}
+
+type RetryValue struct {
+	BaseBackoff          basetypes.StringValue `tfsdk:"base_backoff"`
+	Jitter               basetypes.BoolValue   `tfsdk:"jitter"`
+	MaxAttempts          basetypes.Int64Value  `tfsdk:"max_attempts"`
+	MaxBackoff           basetypes.StringValue `tfsdk:"max_backoff"`
+	RetryableStatusCodes basetypes.ListValue   `tfsdk:"retryable_status_codes"`
+	state                attr.ValueState
+}
+
+func (v RetryValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["base_backoff"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["jitter"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["max_attempts"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["max_backoff"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["retryable_status_codes"] = basetypes.ListType{
+		ElemType: types.Int64Type,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.BaseBackoff.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["base_backoff"] = val
+
+		val, err = v.Jitter.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["jitter"] = val
+
+		val, err = v.MaxAttempts.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["max_attempts"] = val
+
+		val, err = v.MaxBackoff.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["max_backoff"] = val
+
+		val, err = v.RetryableStatusCodes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["retryable_status_codes"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v RetryValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v RetryValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v RetryValue) String() string {
+	return "RetryValue"
+}
+
+func (v RetryValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var retryableStatusCodesVal basetypes.ListValue
+	switch {
+	case v.RetryableStatusCodes.IsUnknown():
+		retryableStatusCodesVal = types.ListUnknown(types.Int64Type)
+	case v.RetryableStatusCodes.IsNull():
+		retryableStatusCodesVal = types.ListNull(types.Int64Type)
+	default:
+		var d diag.Diagnostics
+		retryableStatusCodesVal, d = types.ListValue(types.Int64Type, v.RetryableStatusCodes.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_backoff": basetypes.StringType{},
+			"jitter":       basetypes.BoolType{},
+			"max_attempts": basetypes.Int64Type{},
+			"max_backoff":  basetypes.StringType{},
+			"retryable_status_codes": basetypes.ListType{
+				ElemType: types.Int64Type,
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"base_backoff": basetypes.StringType{},
+		"jitter":       basetypes.BoolType{},
+		"max_attempts": basetypes.Int64Type{},
+		"max_backoff":  basetypes.StringType{},
+		"retryable_status_codes": basetypes.ListType{
+			ElemType: types.Int64Type,
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"base_backoff":           v.BaseBackoff,
+			"jitter":                 v.Jitter,
+			"max_attempts":           v.MaxAttempts,
+			"max_backoff":            v.MaxBackoff,
+			"retryable_status_codes": retryableStatusCodesVal,
+		})
+
+	return objVal, diags
+}
+
+func (v RetryValue) Equal(o attr.Value) bool {
+	other, ok := o.(RetryValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.BaseBackoff.Equal(other.BaseBackoff) {
+		return false
+	}
+
+	if !v.Jitter.Equal(other.Jitter) {
+		return false
+	}
+
+	if !v.MaxAttempts.Equal(other.MaxAttempts) {
+		return false
+	}
+
+	if !v.MaxBackoff.Equal(other.MaxBackoff) {
+		return false
+	}
+
+	if !v.RetryableStatusCodes.Equal(other.RetryableStatusCodes) {
+		return false
+	}
+
+	return true
+}
+
+func (v RetryValue) Type(ctx context.Context) attr.Type {
+	return RetryType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v RetryValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"base_backoff": basetypes.StringType{},
+		"jitter":       basetypes.BoolType{},
+		"max_attempts": basetypes.Int64Type{},
+		"max_backoff":  basetypes.StringType{},
+		"retryable_status_codes": basetypes.ListType{
+			ElemType: types.Int64Type,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = ConsoleType{}
+
+type ConsoleType struct {
+	basetypes.ObjectType
+}
+
+func (t ConsoleType) Equal(o attr.Type) bool {
+	other, ok := o.(ConsoleType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ConsoleType) String() string {
+	return "ConsoleType"
+}
+
+func (t ConsoleType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	adminPasswordAttribute, ok := attributes["admin_password"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_password is missing from object`)
+
+		return nil, diags
+	}
+
+	adminPasswordVal, ok := adminPasswordAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_password expected to be basetypes.StringValue, was: %T`, adminPasswordAttribute))
+	}
+
+	adminUserAttribute, ok := attributes["admin_user"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_user is missing from object`)
+
+		return nil, diags
+	}
+
+	adminUserVal, ok := adminUserAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_user expected to be basetypes.StringValue, was: %T`, adminUserAttribute))
+	}
+
+	apiTokenAttribute, ok := attributes["api_token"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_token is missing from object`)
+
+		return nil, diags
+	}
+
+	apiTokenVal, ok := apiTokenAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_token expected to be basetypes.StringValue, was: %T`, apiTokenAttribute))
+	}
+
+	baseUrlAttribute, ok := attributes["base_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_url is missing from object`)
+
+		return nil, diags
+	}
+
+	baseUrlVal, ok := baseUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_url expected to be basetypes.StringValue, was: %T`, baseUrlAttribute))
+	}
+
+	cacertAttribute, ok := attributes["cacert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cacert is missing from object`)
+
+		return nil, diags
+	}
+
+	cacertVal, ok := cacertAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cacert expected to be basetypes.StringValue, was: %T`, cacertAttribute))
+	}
+
+	certAttribute, ok := attributes["cert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cert is missing from object`)
+
+		return nil, diags
+	}
+
+	certVal, ok := certAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cert expected to be basetypes.StringValue, was: %T`, certAttribute))
+	}
+
+	insecureAttribute, ok := attributes["insecure"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`insecure is missing from object`)
+
+		return nil, diags
+	}
+
+	insecureVal, ok := insecureAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`insecure expected to be basetypes.BoolValue, was: %T`, insecureAttribute))
+	}
+
+	keyAttribute, ok := attributes["key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`key is missing from object`)
+
+		return nil, diags
+	}
+
+	keyVal, ok := keyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`key expected to be basetypes.StringValue, was: %T`, keyAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ConsoleValue{
+		AdminPassword: adminPasswordVal,
+		AdminUser:     adminUserVal,
+		ApiToken:      apiTokenVal,
+		BaseUrl:       baseUrlVal,
+		Cacert:        cacertVal,
+		Cert:          certVal,
+		Insecure:      insecureVal,
+		Key:           keyVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewConsoleValueNull() ConsoleValue {
+	return ConsoleValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewConsoleValueUnknown() ConsoleValue {
+	return ConsoleValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewConsoleValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ConsoleValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ConsoleValue Attribute Value",
+				"While creating a ConsoleValue value, a missing attribute value was detected. "+
+					"A ConsoleValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ConsoleValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ConsoleValue Attribute Type",
+				"While creating a ConsoleValue value, an invalid attribute value was detected. "+
+					"A ConsoleValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ConsoleValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ConsoleValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ConsoleValue Attribute Value",
+				"While creating a ConsoleValue value, an extra attribute value was detected. "+
+					"A ConsoleValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ConsoleValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewConsoleValueUnknown(), diags
+	}
+
+	adminPasswordAttribute, ok := attributes["admin_password"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_password is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	adminPasswordVal, ok := adminPasswordAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_password expected to be basetypes.StringValue, was: %T`, adminPasswordAttribute))
+	}
+
+	adminUserAttribute, ok := attributes["admin_user"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_user is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	adminUserVal, ok := adminUserAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_user expected to be basetypes.StringValue, was: %T`, adminUserAttribute))
+	}
+
+	apiTokenAttribute, ok := attributes["api_token"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`api_token is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	apiTokenVal, ok := apiTokenAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`api_token expected to be basetypes.StringValue, was: %T`, apiTokenAttribute))
+	}
+
+	baseUrlAttribute, ok := attributes["base_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_url is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	baseUrlVal, ok := baseUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_url expected to be basetypes.StringValue, was: %T`, baseUrlAttribute))
+	}
+
+	cacertAttribute, ok := attributes["cacert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cacert is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	cacertVal, ok := cacertAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cacert expected to be basetypes.StringValue, was: %T`, cacertAttribute))
+	}
+
+	certAttribute, ok := attributes["cert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cert is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	certVal, ok := certAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cert expected to be basetypes.StringValue, was: %T`, certAttribute))
+	}
+
+	insecureAttribute, ok := attributes["insecure"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`insecure is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	insecureVal, ok := insecureAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`insecure expected to be basetypes.BoolValue, was: %T`, insecureAttribute))
+	}
+
+	keyAttribute, ok := attributes["key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`key is missing from object`)
+
+		return NewConsoleValueUnknown(), diags
+	}
+
+	keyVal, ok := keyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`key expected to be basetypes.StringValue, was: %T`, keyAttribute))
+	}
+
+	if diags.HasError() {
+		return NewConsoleValueUnknown(), diags
+	}
+
+	return ConsoleValue{
+		AdminPassword: adminPasswordVal,
+		AdminUser:     adminUserVal,
+		ApiToken:      apiTokenVal,
+		BaseUrl:       baseUrlVal,
+		Cacert:        cacertVal,
+		Cert:          certVal,
+		Insecure:      insecureVal,
+		Key:           keyVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewConsoleValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ConsoleValue {
+	object, diags := NewConsoleValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewConsoleValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ConsoleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewConsoleValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewConsoleValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewConsoleValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewConsoleValueMust(ConsoleValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ConsoleType) ValueType(ctx context.Context) attr.Value {
+	return ConsoleValue{}
+}
+
+var _ basetypes.ObjectValuable = ConsoleValue{}
+
+type ConsoleValue struct {
+	AdminPassword basetypes.StringValue `tfsdk:"admin_password"`
+	AdminUser     basetypes.StringValue `tfsdk:"admin_user"`
+	ApiToken      basetypes.StringValue `tfsdk:"api_token"`
+	BaseUrl       basetypes.StringValue `tfsdk:"base_url"`
+	Cacert        basetypes.StringValue `tfsdk:"cacert"`
+	Cert          basetypes.StringValue `tfsdk:"cert"`
+	Insecure      basetypes.BoolValue   `tfsdk:"insecure"`
+	Key           basetypes.StringValue `tfsdk:"key"`
+	state         attr.ValueState
+}
+
+func (v ConsoleValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 8)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["admin_password"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["admin_user"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["api_token"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["base_url"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cacert"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cert"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["insecure"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["key"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 8)
+
+		val, err = v.AdminPassword.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["admin_password"] = val
+
+		val, err = v.AdminUser.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["admin_user"] = val
+
+		val, err = v.ApiToken.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["api_token"] = val
+
+		val, err = v.BaseUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["base_url"] = val
+
+		val, err = v.Cacert.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cacert"] = val
+
+		val, err = v.Cert.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cert"] = val
+
+		val, err = v.Insecure.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["insecure"] = val
+
+		val, err = v.Key.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["key"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ConsoleValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ConsoleValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ConsoleValue) String() string {
+	return "ConsoleValue"
+}
+
+func (v ConsoleValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"admin_password": basetypes.StringType{},
+		"admin_user":     basetypes.StringType{},
+		"api_token":      basetypes.StringType{},
+		"base_url":       basetypes.StringType{},
+		"cacert":         basetypes.StringType{},
+		"cert":           basetypes.StringType{},
+		"insecure":       basetypes.BoolType{},
+		"key":            basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"admin_password": v.AdminPassword,
+			"admin_user":     v.AdminUser,
+			"api_token":      v.ApiToken,
+			"base_url":       v.BaseUrl,
+			"cacert":         v.Cacert,
+			"cert":           v.Cert,
+			"insecure":       v.Insecure,
+			"key":            v.Key,
+		})
+
+	return objVal, diags
+}
+
+func (v ConsoleValue) Equal(o attr.Value) bool {
+	other, ok := o.(ConsoleValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.AdminPassword.Equal(other.AdminPassword) {
+		return false
+	}
+
+	if !v.AdminUser.Equal(other.AdminUser) {
+		return false
+	}
+
+	if !v.ApiToken.Equal(other.ApiToken) {
+		return false
+	}
+
+	if !v.BaseUrl.Equal(other.BaseUrl) {
+		return false
+	}
+
+	if !v.Cacert.Equal(other.Cacert) {
+		return false
+	}
+
+	if !v.Cert.Equal(other.Cert) {
+		return false
+	}
+
+	if !v.Insecure.Equal(other.Insecure) {
+		return false
+	}
+
+	if !v.Key.Equal(other.Key) {
+		return false
+	}
+
+	return true
+}
+
+func (v ConsoleValue) Type(ctx context.Context) attr.Type {
+	return ConsoleType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ConsoleValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"admin_password": basetypes.StringType{},
+		"admin_user":     basetypes.StringType{},
+		"api_token":      basetypes.StringType{},
+		"base_url":       basetypes.StringType{},
+		"cacert":         basetypes.StringType{},
+		"cert":           basetypes.StringType{},
+		"insecure":       basetypes.BoolType{},
+		"key":            basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = GatewayType{}
+
+type GatewayType struct {
+	basetypes.ObjectType
+}
+
+func (t GatewayType) Equal(o attr.Type) bool {
+	other, ok := o.(GatewayType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t GatewayType) String() string {
+	return "GatewayType"
+}
+
+func (t GatewayType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	adminPasswordAttribute, ok := attributes["admin_password"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_password is missing from object`)
+
+		return nil, diags
+	}
+
+	adminPasswordVal, ok := adminPasswordAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_password expected to be basetypes.StringValue, was: %T`, adminPasswordAttribute))
+	}
+
+	adminUserAttribute, ok := attributes["admin_user"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_user is missing from object`)
+
+		return nil, diags
+	}
+
+	adminUserVal, ok := adminUserAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_user expected to be basetypes.StringValue, was: %T`, adminUserAttribute))
+	}
+
+	baseUrlAttribute, ok := attributes["base_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_url is missing from object`)
+
+		return nil, diags
+	}
+
+	baseUrlVal, ok := baseUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_url expected to be basetypes.StringValue, was: %T`, baseUrlAttribute))
+	}
+
+	cacertAttribute, ok := attributes["cacert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cacert is missing from object`)
+
+		return nil, diags
+	}
+
+	cacertVal, ok := cacertAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cacert expected to be basetypes.StringValue, was: %T`, cacertAttribute))
+	}
+
+	certAttribute, ok := attributes["cert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cert is missing from object`)
+
+		return nil, diags
+	}
+
+	certVal, ok := certAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cert expected to be basetypes.StringValue, was: %T`, certAttribute))
+	}
+
+	insecureAttribute, ok := attributes["insecure"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`insecure is missing from object`)
+
+		return nil, diags
+	}
+
+	insecureVal, ok := insecureAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`insecure expected to be basetypes.BoolValue, was: %T`, insecureAttribute))
+	}
+
+	keyAttribute, ok := attributes["key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`key is missing from object`)
+
+		return nil, diags
+	}
+
+	keyVal, ok := keyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`key expected to be basetypes.StringValue, was: %T`, keyAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return GatewayValue{
+		AdminPassword: adminPasswordVal,
+		AdminUser:     adminUserVal,
+		BaseUrl:       baseUrlVal,
+		Cacert:        cacertVal,
+		Cert:          certVal,
+		Insecure:      insecureVal,
+		Key:           keyVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewGatewayValueNull() GatewayValue {
+	return GatewayValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewGatewayValueUnknown() GatewayValue {
+	return GatewayValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewGatewayValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (GatewayValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing GatewayValue Attribute Value",
+				"While creating a GatewayValue value, a missing attribute value was detected. "+
+					"A GatewayValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("GatewayValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid GatewayValue Attribute Type",
+				"While creating a GatewayValue value, an invalid attribute value was detected. "+
+					"A GatewayValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("GatewayValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("GatewayValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra GatewayValue Attribute Value",
+				"While creating a GatewayValue value, an extra attribute value was detected. "+
+					"A GatewayValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra GatewayValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewGatewayValueUnknown(), diags
+	}
+
+	adminPasswordAttribute, ok := attributes["admin_password"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_password is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	adminPasswordVal, ok := adminPasswordAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_password expected to be basetypes.StringValue, was: %T`, adminPasswordAttribute))
+	}
+
+	adminUserAttribute, ok := attributes["admin_user"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`admin_user is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	adminUserVal, ok := adminUserAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`admin_user expected to be basetypes.StringValue, was: %T`, adminUserAttribute))
+	}
+
+	baseUrlAttribute, ok := attributes["base_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_url is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	baseUrlVal, ok := baseUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_url expected to be basetypes.StringValue, was: %T`, baseUrlAttribute))
+	}
+
+	cacertAttribute, ok := attributes["cacert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cacert is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	cacertVal, ok := cacertAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cacert expected to be basetypes.StringValue, was: %T`, cacertAttribute))
+	}
+
+	certAttribute, ok := attributes["cert"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cert is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	certVal, ok := certAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cert expected to be basetypes.StringValue, was: %T`, certAttribute))
+	}
+
+	insecureAttribute, ok := attributes["insecure"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`insecure is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	insecureVal, ok := insecureAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`insecure expected to be basetypes.BoolValue, was: %T`, insecureAttribute))
+	}
+
+	keyAttribute, ok := attributes["key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`key is missing from object`)
+
+		return NewGatewayValueUnknown(), diags
+	}
+
+	keyVal, ok := keyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`key expected to be basetypes.StringValue, was: %T`, keyAttribute))
+	}
+
+	if diags.HasError() {
+		return NewGatewayValueUnknown(), diags
+	}
+
+	return GatewayValue{
+		AdminPassword: adminPasswordVal,
+		AdminUser:     adminUserVal,
+		BaseUrl:       baseUrlVal,
+		Cacert:        cacertVal,
+		Cert:          certVal,
+		Insecure:      insecureVal,
+		Key:           keyVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewGatewayValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) GatewayValue {
+	object, diags := NewGatewayValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewGatewayValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t GatewayType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewGatewayValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewGatewayValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewGatewayValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewGatewayValueMust(GatewayValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t GatewayType) ValueType(ctx context.Context) attr.Value {
+	return GatewayValue{}
+}
+
+var _ basetypes.ObjectValuable = GatewayValue{}
+
+type GatewayValue struct {
+	AdminPassword basetypes.StringValue `tfsdk:"admin_password"`
+	AdminUser     basetypes.StringValue `tfsdk:"admin_user"`
+	BaseUrl       basetypes.StringValue `tfsdk:"base_url"`
+	Cacert        basetypes.StringValue `tfsdk:"cacert"`
+	Cert          basetypes.StringValue `tfsdk:"cert"`
+	Insecure      basetypes.BoolValue   `tfsdk:"insecure"`
+	Key           basetypes.StringValue `tfsdk:"key"`
+	state         attr.ValueState
+}
+
+func (v GatewayValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["admin_password"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["admin_user"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["base_url"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cacert"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["cert"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["insecure"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["key"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 7)
+
+		val, err = v.AdminPassword.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["admin_password"] = val
+
+		val, err = v.AdminUser.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["admin_user"] = val
+
+		val, err = v.BaseUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["base_url"] = val
+
+		val, err = v.Cacert.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cacert"] = val
+
+		val, err = v.Cert.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cert"] = val
+
+		val, err = v.Insecure.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["insecure"] = val
+
+		val, err = v.Key.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["key"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v GatewayValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v GatewayValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v GatewayValue) String() string {
+	return "GatewayValue"
+}
+
+func (v GatewayValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"admin_password": basetypes.StringType{},
+		"admin_user":     basetypes.StringType{},
+		"base_url":       basetypes.StringType{},
+		"cacert":         basetypes.StringType{},
+		"cert":           basetypes.StringType{},
+		"insecure":       basetypes.BoolType{},
+		"key":            basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"admin_password": v.AdminPassword,
+			"admin_user":     v.AdminUser,
+			"base_url":       v.BaseUrl,
+			"cacert":         v.Cacert,
+			"cert":           v.Cert,
+			"insecure":       v.Insecure,
+			"key":            v.Key,
+		})
+
+	return objVal, diags
+}
+
+func (v GatewayValue) Equal(o attr.Value) bool {
+	other, ok := o.(GatewayValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.AdminPassword.Equal(other.AdminPassword) {
+		return false
+	}
+
+	if !v.AdminUser.Equal(other.AdminUser) {
+		return false
+	}
+
+	if !v.BaseUrl.Equal(other.BaseUrl) {
+		return false
+	}
+
+	if !v.Cacert.Equal(other.Cacert) {
+		return false
+	}
+
+	if !v.Cert.Equal(other.Cert) {
+		return false
+	}
+
+	if !v.Insecure.Equal(other.Insecure) {
+		return false
+	}
+
+	if !v.Key.Equal(other.Key) {
+		return false
+	}
+
+	return true
+}
+
+func (v GatewayValue) Type(ctx context.Context) attr.Type {
+	return GatewayType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v GatewayValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"admin_password": basetypes.StringType{},
+		"admin_user":     basetypes.StringType{},
+		"base_url":       basetypes.StringType{},
+		"cacert":         basetypes.StringType{},
+		"cert":           basetypes.StringType{},
+		"insecure":       basetypes.BoolType{},
+		"key":            basetypes.StringType{},
+	}
 }
@@ -0,0 +1,1958 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_console_alert_v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ConsoleAlertV3ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_instance": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Application instance owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				MarkdownDescription: "Application instance owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group"), path.MatchRoot("user")),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Group owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				MarkdownDescription: "Group owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_instance"), path.MatchRoot("user")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Alert name, must be unique for its owner, acts as an ID for import",
+				MarkdownDescription: "Alert name, must be unique for its owner, acts as an ID for import",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-z\\_\\-.]+$"), ""),
+				},
+			},
+			"spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"cluster": schema.StringAttribute{
+						Required:            true,
+						Description:         "Kafka cluster the alert monitors",
+						MarkdownDescription: "Kafka cluster the alert monitors",
+					},
+					"connect_name": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Kafka Connect server of the connector monitored by a `KafkaConnectAlert`",
+						MarkdownDescription: "Kafka Connect server of the connector monitored by a `KafkaConnectAlert`",
+					},
+					"connector_name": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Connector monitored by a `KafkaConnectAlert`",
+						MarkdownDescription: "Connector monitored by a `KafkaConnectAlert`",
+					},
+					"consumer_group_name": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Consumer group monitored by a `ConsumerGroupAlert`",
+						MarkdownDescription: "Consumer group monitored by a `ConsumerGroupAlert`",
+					},
+					"description": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Alert description",
+						MarkdownDescription: "Alert description",
+					},
+					"destination": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"body": schema.StringAttribute{
+								Optional:            true,
+								Description:         "HTTP body of `Webhook` destinations",
+								MarkdownDescription: "HTTP body of `Webhook` destinations",
+							},
+							"channel": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Slack channel, required for `Slack` destinations",
+								MarkdownDescription: "Slack channel, required for `Slack` destinations",
+							},
+							"emails": schema.SetAttribute{
+								ElementType:         types.StringType,
+								Optional:            true,
+								Description:         "Email addresses, required for `Email` destinations",
+								MarkdownDescription: "Email addresses, required for `Email` destinations",
+							},
+							"headers": schema.MapAttribute{
+								ElementType:         types.StringType,
+								Optional:            true,
+								Sensitive:           true,
+								Description:         "HTTP headers of `Webhook` destinations",
+								MarkdownDescription: "HTTP headers of `Webhook` destinations",
+							},
+							"method": schema.StringAttribute{
+								Optional:            true,
+								Description:         "HTTP method of `Webhook` destinations, one of `DELETE`, `GET`, `PATCH`, `POST` or `PUT`",
+								MarkdownDescription: "HTTP method of `Webhook` destinations, one of `DELETE`, `GET`, `PATCH`, `POST` or `PUT`",
+								Validators: []validator.String{
+									stringvalidator.OneOf(validation.ValidAlertWebhookMethods...),
+								},
+							},
+							"type": schema.StringAttribute{
+								Required:            true,
+								Description:         "Destination type, one of `Email`, `Slack`, `Teams` or `Webhook`",
+								MarkdownDescription: "Destination type, one of `Email`, `Slack`, `Teams` or `Webhook`",
+								Validators: []validator.String{
+									stringvalidator.OneOf(validation.ValidAlertDestinationTypes...),
+								},
+							},
+							"url": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Webhook URL, required for `Teams` and `Webhook` destinations",
+								MarkdownDescription: "Webhook URL, required for `Teams` and `Webhook` destinations",
+							},
+						},
+						CustomType: DestinationType{
+							ObjectType: types.ObjectType{
+								AttrTypes: DestinationValue{}.AttributeTypes(ctx),
+							},
+						},
+						Required:            true,
+						Description:         "Destination the alert is sent to",
+						MarkdownDescription: "Destination the alert is sent to",
+					},
+					"disable": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Whether the alert is disabled. Defaults to false.",
+						MarkdownDescription: "Whether the alert is disabled. Defaults to false.",
+						Default:             booldefault.StaticBool(false),
+					},
+					"metric": schema.StringAttribute{
+						Required:            true,
+						Description:         "Metric compared to the threshold, depending on the alert type, like `MessageCount` for a `TopicAlert` or `OffsetLag` for a `ConsumerGroupAlert`",
+						MarkdownDescription: "Metric compared to the threshold, depending on the alert type, like `MessageCount` for a `TopicAlert` or `OffsetLag` for a `ConsumerGroupAlert`",
+					},
+					"operator": schema.StringAttribute{
+						Required:            true,
+						Description:         "Comparison of the metric with the threshold, one of `GreaterThan`, `GreaterThanOrEqual`, `LessThan`, `LessThanOrEqual` or `NotEqual`",
+						MarkdownDescription: "Comparison of the metric with the threshold, one of `GreaterThan`, `GreaterThanOrEqual`, `LessThan`, `LessThanOrEqual` or `NotEqual`",
+						Validators: []validator.String{
+							stringvalidator.OneOf(validation.ValidAlertOperators...),
+						},
+					},
+					"prom_ql": schema.StringAttribute{
+						Computed:            true,
+						Description:         "PromQL query evaluating the alert, computed by Console.",
+						MarkdownDescription: "PromQL query evaluating the alert, computed by Console.",
+					},
+					"threshold": schema.Int64Attribute{
+						Required:            true,
+						Description:         "Threshold the metric is compared to",
+						MarkdownDescription: "Threshold the metric is compared to",
+					},
+					"topic_name": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Topic monitored by a `TopicAlert`",
+						MarkdownDescription: "Topic monitored by a `TopicAlert`",
+					},
+					"type": schema.StringAttribute{
+						Required:            true,
+						Description:         "Alert type, one of `BrokerAlert`, `ConsumerGroupAlert`, `KafkaConnectAlert` or `TopicAlert`",
+						MarkdownDescription: "Alert type, one of `BrokerAlert`, `ConsumerGroupAlert`, `KafkaConnectAlert` or `TopicAlert`",
+						Validators: []validator.String{
+							stringvalidator.OneOf(validation.ValidAlertTypes...),
+						},
+					},
+				},
+				CustomType: SpecType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SpecValue{}.AttributeTypes(ctx),
+					},
+				},
+				Required:            true,
+				Description:         "Alert specification",
+				MarkdownDescription: "Alert specification",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Last update time of the alert, computed by Console.",
+				MarkdownDescription: "Last update time of the alert, computed by Console.",
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Description:         "User owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				MarkdownDescription: "User owning the alert. Exactly one of `app_instance`, `group` or `user` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_instance"), path.MatchRoot("group")),
+				},
+			},
+		},
+	}
+}
+
+type ConsoleAlertV3Model struct {
+	AppInstance types.String `tfsdk:"app_instance"`
+	Group       types.String `tfsdk:"group"`
+	Name        types.String `tfsdk:"name"`
+	Spec        SpecValue    `tfsdk:"spec"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	User        types.String `tfsdk:"user"`
+}
+
+var _ basetypes.ObjectTypable = SpecType{}
+
+type SpecType struct {
+	basetypes.ObjectType
+}
+
+func (t SpecType) Equal(o attr.Type) bool {
+	other, ok := o.(SpecType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SpecType) String() string {
+	return "SpecType"
+}
+
+func (t SpecType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	clusterAttribute, ok := attributes["cluster"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cluster is missing from object`)
+
+		return nil, diags
+	}
+
+	clusterVal, ok := clusterAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cluster expected to be basetypes.StringValue, was: %T`, clusterAttribute))
+	}
+
+	connectNameAttribute, ok := attributes["connect_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connect_name is missing from object`)
+
+		return nil, diags
+	}
+
+	connectNameVal, ok := connectNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connect_name expected to be basetypes.StringValue, was: %T`, connectNameAttribute))
+	}
+
+	connectorNameAttribute, ok := attributes["connector_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connector_name is missing from object`)
+
+		return nil, diags
+	}
+
+	connectorNameVal, ok := connectorNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connector_name expected to be basetypes.StringValue, was: %T`, connectorNameAttribute))
+	}
+
+	consumerGroupNameAttribute, ok := attributes["consumer_group_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`consumer_group_name is missing from object`)
+
+		return nil, diags
+	}
+
+	consumerGroupNameVal, ok := consumerGroupNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`consumer_group_name expected to be basetypes.StringValue, was: %T`, consumerGroupNameAttribute))
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return nil, diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	destinationAttribute, ok := attributes["destination"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination is missing from object`)
+
+		return nil, diags
+	}
+
+	destinationVal, ok := destinationAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination expected to be basetypes.ObjectValue, was: %T`, destinationAttribute))
+	}
+
+	disableAttribute, ok := attributes["disable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`disable is missing from object`)
+
+		return nil, diags
+	}
+
+	disableVal, ok := disableAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`disable expected to be basetypes.BoolValue, was: %T`, disableAttribute))
+	}
+
+	metricAttribute, ok := attributes["metric"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`metric is missing from object`)
+
+		return nil, diags
+	}
+
+	metricVal, ok := metricAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`metric expected to be basetypes.StringValue, was: %T`, metricAttribute))
+	}
+
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return nil, diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
+	promQlAttribute, ok := attributes["prom_ql"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`prom_ql is missing from object`)
+
+		return nil, diags
+	}
+
+	promQlVal, ok := promQlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`prom_ql expected to be basetypes.StringValue, was: %T`, promQlAttribute))
+	}
+
+	thresholdAttribute, ok := attributes["threshold"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`threshold is missing from object`)
+
+		return nil, diags
+	}
+
+	thresholdVal, ok := thresholdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`threshold expected to be basetypes.Int64Value, was: %T`, thresholdAttribute))
+	}
+
+	topicNameAttribute, ok := attributes["topic_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`topic_name is missing from object`)
+
+		return nil, diags
+	}
+
+	topicNameVal, ok := topicNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`topic_name expected to be basetypes.StringValue, was: %T`, topicNameAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SpecValue{
+		Cluster:           clusterVal,
+		ConnectName:       connectNameVal,
+		ConnectorName:     connectorNameVal,
+		ConsumerGroupName: consumerGroupNameVal,
+		Description:       descriptionVal,
+		Destination:       destinationVal,
+		Disable:           disableVal,
+		Metric:            metricVal,
+		Operator:          operatorVal,
+		PromQl:            promQlVal,
+		Threshold:         thresholdVal,
+		TopicName:         topicNameVal,
+		SpecType:          typeVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueNull() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSpecValueUnknown() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSpecValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SpecValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SpecValue Attribute Value",
+				"While creating a SpecValue value, a missing attribute value was detected. "+
+					"A SpecValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SpecValue Attribute Type",
+				"While creating a SpecValue value, an invalid attribute value was detected. "+
+					"A SpecValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SpecValue Attribute Value",
+				"While creating a SpecValue value, an extra attribute value was detected. "+
+					"A SpecValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SpecValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	clusterAttribute, ok := attributes["cluster"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cluster is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	clusterVal, ok := clusterAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cluster expected to be basetypes.StringValue, was: %T`, clusterAttribute))
+	}
+
+	connectNameAttribute, ok := attributes["connect_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connect_name is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	connectNameVal, ok := connectNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connect_name expected to be basetypes.StringValue, was: %T`, connectNameAttribute))
+	}
+
+	connectorNameAttribute, ok := attributes["connector_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connector_name is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	connectorNameVal, ok := connectorNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connector_name expected to be basetypes.StringValue, was: %T`, connectorNameAttribute))
+	}
+
+	consumerGroupNameAttribute, ok := attributes["consumer_group_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`consumer_group_name is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	consumerGroupNameVal, ok := consumerGroupNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`consumer_group_name expected to be basetypes.StringValue, was: %T`, consumerGroupNameAttribute))
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	destinationAttribute, ok := attributes["destination"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`destination is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	destinationVal, ok := destinationAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`destination expected to be basetypes.ObjectValue, was: %T`, destinationAttribute))
+	}
+
+	disableAttribute, ok := attributes["disable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`disable is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	disableVal, ok := disableAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`disable expected to be basetypes.BoolValue, was: %T`, disableAttribute))
+	}
+
+	metricAttribute, ok := attributes["metric"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`metric is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	metricVal, ok := metricAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`metric expected to be basetypes.StringValue, was: %T`, metricAttribute))
+	}
+
+	operatorAttribute, ok := attributes["operator"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`operator is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	operatorVal, ok := operatorAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`operator expected to be basetypes.StringValue, was: %T`, operatorAttribute))
+	}
+
+	promQlAttribute, ok := attributes["prom_ql"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`prom_ql is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	promQlVal, ok := promQlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`prom_ql expected to be basetypes.StringValue, was: %T`, promQlAttribute))
+	}
+
+	thresholdAttribute, ok := attributes["threshold"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`threshold is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	thresholdVal, ok := thresholdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`threshold expected to be basetypes.Int64Value, was: %T`, thresholdAttribute))
+	}
+
+	topicNameAttribute, ok := attributes["topic_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`topic_name is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	topicNameVal, ok := topicNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`topic_name expected to be basetypes.StringValue, was: %T`, topicNameAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	return SpecValue{
+		Cluster:           clusterVal,
+		ConnectName:       connectNameVal,
+		ConnectorName:     connectorNameVal,
+		ConsumerGroupName: consumerGroupNameVal,
+		Description:       descriptionVal,
+		Destination:       destinationVal,
+		Disable:           disableVal,
+		Metric:            metricVal,
+		Operator:          operatorVal,
+		PromQl:            promQlVal,
+		Threshold:         thresholdVal,
+		TopicName:         topicNameVal,
+		SpecType:          typeVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SpecValue {
+	object, diags := NewSpecValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSpecValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SpecType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSpecValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSpecValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSpecValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSpecValueMust(SpecValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SpecType) ValueType(ctx context.Context) attr.Value {
+	return SpecValue{}
+}
+
+var _ basetypes.ObjectValuable = SpecValue{}
+
+type SpecValue struct {
+	Cluster           basetypes.StringValue `tfsdk:"cluster"`
+	ConnectName       basetypes.StringValue `tfsdk:"connect_name"`
+	ConnectorName     basetypes.StringValue `tfsdk:"connector_name"`
+	ConsumerGroupName basetypes.StringValue `tfsdk:"consumer_group_name"`
+	Description       basetypes.StringValue `tfsdk:"description"`
+	Destination       basetypes.ObjectValue `tfsdk:"destination"`
+	Disable           basetypes.BoolValue   `tfsdk:"disable"`
+	Metric            basetypes.StringValue `tfsdk:"metric"`
+	Operator          basetypes.StringValue `tfsdk:"operator"`
+	PromQl            basetypes.StringValue `tfsdk:"prom_ql"`
+	Threshold         basetypes.Int64Value  `tfsdk:"threshold"`
+	TopicName         basetypes.StringValue `tfsdk:"topic_name"`
+	SpecType          basetypes.StringValue `tfsdk:"type"`
+	state             attr.ValueState
+}
+
+func (v SpecValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 13)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["cluster"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["connect_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["connector_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["consumer_group_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["description"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["destination"] = basetypes.ObjectType{
+		AttrTypes: DestinationValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["disable"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["metric"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["operator"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["prom_ql"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["threshold"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["topic_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 13)
+
+		val, err = v.Cluster.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cluster"] = val
+
+		val, err = v.ConnectName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["connect_name"] = val
+
+		val, err = v.ConnectorName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["connector_name"] = val
+
+		val, err = v.ConsumerGroupName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["consumer_group_name"] = val
+
+		val, err = v.Description.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["description"] = val
+
+		val, err = v.Destination.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["destination"] = val
+
+		val, err = v.Disable.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["disable"] = val
+
+		val, err = v.Metric.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["metric"] = val
+
+		val, err = v.Operator.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["operator"] = val
+
+		val, err = v.PromQl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["prom_ql"] = val
+
+		val, err = v.Threshold.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["threshold"] = val
+
+		val, err = v.TopicName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["topic_name"] = val
+
+		val, err = v.SpecType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SpecValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SpecValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SpecValue) String() string {
+	return "SpecValue"
+}
+
+func (v SpecValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var destinationVal basetypes.ObjectValue
+
+	if v.Destination.IsNull() {
+		destinationVal = types.ObjectNull(
+			DestinationValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Destination.IsUnknown() {
+		destinationVal = types.ObjectUnknown(
+			DestinationValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Destination.IsNull() && !v.Destination.IsUnknown() {
+		destinationVal = types.ObjectValueMust(
+			DestinationValue{}.AttributeTypes(ctx),
+			v.Destination.Attributes(),
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"cluster":             basetypes.StringType{},
+		"connect_name":        basetypes.StringType{},
+		"connector_name":      basetypes.StringType{},
+		"consumer_group_name": basetypes.StringType{},
+		"description":         basetypes.StringType{},
+		"destination": basetypes.ObjectType{
+			AttrTypes: DestinationValue{}.AttributeTypes(ctx),
+		},
+		"disable":    basetypes.BoolType{},
+		"metric":     basetypes.StringType{},
+		"operator":   basetypes.StringType{},
+		"prom_ql":    basetypes.StringType{},
+		"threshold":  basetypes.Int64Type{},
+		"topic_name": basetypes.StringType{},
+		"type":       basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"cluster":             v.Cluster,
+			"connect_name":        v.ConnectName,
+			"connector_name":      v.ConnectorName,
+			"consumer_group_name": v.ConsumerGroupName,
+			"description":         v.Description,
+			"destination":         destinationVal,
+			"disable":             v.Disable,
+			"metric":              v.Metric,
+			"operator":            v.Operator,
+			"prom_ql":             v.PromQl,
+			"threshold":           v.Threshold,
+			"topic_name":          v.TopicName,
+			"type":                v.SpecType,
+		})
+
+	return objVal, diags
+}
+
+func (v SpecValue) Equal(o attr.Value) bool {
+	other, ok := o.(SpecValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Cluster.Equal(other.Cluster) {
+		return false
+	}
+
+	if !v.ConnectName.Equal(other.ConnectName) {
+		return false
+	}
+
+	if !v.ConnectorName.Equal(other.ConnectorName) {
+		return false
+	}
+
+	if !v.ConsumerGroupName.Equal(other.ConsumerGroupName) {
+		return false
+	}
+
+	if !v.Description.Equal(other.Description) {
+		return false
+	}
+
+	if !v.Destination.Equal(other.Destination) {
+		return false
+	}
+
+	if !v.Disable.Equal(other.Disable) {
+		return false
+	}
+
+	if !v.Metric.Equal(other.Metric) {
+		return false
+	}
+
+	if !v.Operator.Equal(other.Operator) {
+		return false
+	}
+
+	if !v.PromQl.Equal(other.PromQl) {
+		return false
+	}
+
+	if !v.Threshold.Equal(other.Threshold) {
+		return false
+	}
+
+	if !v.TopicName.Equal(other.TopicName) {
+		return false
+	}
+
+	if !v.SpecType.Equal(other.SpecType) {
+		return false
+	}
+
+	return true
+}
+
+func (v SpecValue) Type(ctx context.Context) attr.Type {
+	return SpecType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SpecValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster":             basetypes.StringType{},
+		"connect_name":        basetypes.StringType{},
+		"connector_name":      basetypes.StringType{},
+		"consumer_group_name": basetypes.StringType{},
+		"description":         basetypes.StringType{},
+		"destination": basetypes.ObjectType{
+			AttrTypes: DestinationValue{}.AttributeTypes(ctx),
+		},
+		"disable":    basetypes.BoolType{},
+		"metric":     basetypes.StringType{},
+		"operator":   basetypes.StringType{},
+		"prom_ql":    basetypes.StringType{},
+		"threshold":  basetypes.Int64Type{},
+		"topic_name": basetypes.StringType{},
+		"type":       basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = DestinationType{}
+
+type DestinationType struct {
+	basetypes.ObjectType
+}
+
+func (t DestinationType) Equal(o attr.Type) bool {
+	other, ok := o.(DestinationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t DestinationType) String() string {
+	return "DestinationType"
+}
+
+func (t DestinationType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	bodyAttribute, ok := attributes["body"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`body is missing from object`)
+
+		return nil, diags
+	}
+
+	bodyVal, ok := bodyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`body expected to be basetypes.StringValue, was: %T`, bodyAttribute))
+	}
+
+	channelAttribute, ok := attributes["channel"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`channel is missing from object`)
+
+		return nil, diags
+	}
+
+	channelVal, ok := channelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`channel expected to be basetypes.StringValue, was: %T`, channelAttribute))
+	}
+
+	emailsAttribute, ok := attributes["emails"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`emails is missing from object`)
+
+		return nil, diags
+	}
+
+	emailsVal, ok := emailsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`emails expected to be basetypes.SetValue, was: %T`, emailsAttribute))
+	}
+
+	headersAttribute, ok := attributes["headers"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`headers is missing from object`)
+
+		return nil, diags
+	}
+
+	headersVal, ok := headersAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`headers expected to be basetypes.MapValue, was: %T`, headersAttribute))
+	}
+
+	methodAttribute, ok := attributes["method"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`method is missing from object`)
+
+		return nil, diags
+	}
+
+	methodVal, ok := methodAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`method expected to be basetypes.StringValue, was: %T`, methodAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	urlAttribute, ok := attributes["url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`url is missing from object`)
+
+		return nil, diags
+	}
+
+	urlVal, ok := urlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return DestinationValue{
+		Body:            bodyVal,
+		Channel:         channelVal,
+		Emails:          emailsVal,
+		Headers:         headersVal,
+		Method:          methodVal,
+		DestinationType: typeVal,
+		Url:             urlVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDestinationValueNull() DestinationValue {
+	return DestinationValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewDestinationValueUnknown() DestinationValue {
+	return DestinationValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewDestinationValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DestinationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing DestinationValue Attribute Value",
+				"While creating a DestinationValue value, a missing attribute value was detected. "+
+					"A DestinationValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DestinationValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid DestinationValue Attribute Type",
+				"While creating a DestinationValue value, an invalid attribute value was detected. "+
+					"A DestinationValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DestinationValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("DestinationValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra DestinationValue Attribute Value",
+				"While creating a DestinationValue value, an extra attribute value was detected. "+
+					"A DestinationValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra DestinationValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewDestinationValueUnknown(), diags
+	}
+
+	bodyAttribute, ok := attributes["body"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`body is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	bodyVal, ok := bodyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`body expected to be basetypes.StringValue, was: %T`, bodyAttribute))
+	}
+
+	channelAttribute, ok := attributes["channel"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`channel is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	channelVal, ok := channelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`channel expected to be basetypes.StringValue, was: %T`, channelAttribute))
+	}
+
+	emailsAttribute, ok := attributes["emails"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`emails is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	emailsVal, ok := emailsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`emails expected to be basetypes.SetValue, was: %T`, emailsAttribute))
+	}
+
+	headersAttribute, ok := attributes["headers"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`headers is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	headersVal, ok := headersAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`headers expected to be basetypes.MapValue, was: %T`, headersAttribute))
+	}
+
+	methodAttribute, ok := attributes["method"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`method is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	methodVal, ok := methodAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`method expected to be basetypes.StringValue, was: %T`, methodAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	urlAttribute, ok := attributes["url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`url is missing from object`)
+
+		return NewDestinationValueUnknown(), diags
+	}
+
+	urlVal, ok := urlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`url expected to be basetypes.StringValue, was: %T`, urlAttribute))
+	}
+
+	if diags.HasError() {
+		return NewDestinationValueUnknown(), diags
+	}
+
+	return DestinationValue{
+		Body:            bodyVal,
+		Channel:         channelVal,
+		Emails:          emailsVal,
+		Headers:         headersVal,
+		Method:          methodVal,
+		DestinationType: typeVal,
+		Url:             urlVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDestinationValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DestinationValue {
+	object, diags := NewDestinationValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewDestinationValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t DestinationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewDestinationValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewDestinationValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewDestinationValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewDestinationValueMust(DestinationValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t DestinationType) ValueType(ctx context.Context) attr.Value {
+	return DestinationValue{}
+}
+
+var _ basetypes.ObjectValuable = DestinationValue{}
+
+type DestinationValue struct {
+	Body            basetypes.StringValue `tfsdk:"body"`
+	Channel         basetypes.StringValue `tfsdk:"channel"`
+	Emails          basetypes.SetValue    `tfsdk:"emails"`
+	Headers         basetypes.MapValue    `tfsdk:"headers"`
+	Method          basetypes.StringValue `tfsdk:"method"`
+	DestinationType basetypes.StringValue `tfsdk:"type"`
+	Url             basetypes.StringValue `tfsdk:"url"`
+	state           attr.ValueState
+}
+
+func (v DestinationValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["body"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["channel"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["emails"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["headers"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["method"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["url"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 7)
+
+		val, err = v.Body.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["body"] = val
+
+		val, err = v.Channel.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["channel"] = val
+
+		val, err = v.Emails.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["emails"] = val
+
+		val, err = v.Headers.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["headers"] = val
+
+		val, err = v.Method.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["method"] = val
+
+		val, err = v.DestinationType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		val, err = v.Url.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["url"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v DestinationValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v DestinationValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v DestinationValue) String() string {
+	return "DestinationValue"
+}
+
+func (v DestinationValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var emailsVal basetypes.SetValue
+	switch {
+	case v.Emails.IsUnknown():
+		emailsVal = types.SetUnknown(types.StringType)
+	case v.Emails.IsNull():
+		emailsVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		emailsVal, d = types.SetValue(types.StringType, v.Emails.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"body":    basetypes.StringType{},
+			"channel": basetypes.StringType{},
+			"emails": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"headers": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"method": basetypes.StringType{},
+			"type":   basetypes.StringType{},
+			"url":    basetypes.StringType{},
+		}), diags
+	}
+
+	var headersVal basetypes.MapValue
+	switch {
+	case v.Headers.IsUnknown():
+		headersVal = types.MapUnknown(types.StringType)
+	case v.Headers.IsNull():
+		headersVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		headersVal, d = types.MapValue(types.StringType, v.Headers.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"body":    basetypes.StringType{},
+			"channel": basetypes.StringType{},
+			"emails": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"headers": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"method": basetypes.StringType{},
+			"type":   basetypes.StringType{},
+			"url":    basetypes.StringType{},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"body":    basetypes.StringType{},
+		"channel": basetypes.StringType{},
+		"emails": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"headers": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"method": basetypes.StringType{},
+		"type":   basetypes.StringType{},
+		"url":    basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"body":    v.Body,
+			"channel": v.Channel,
+			"emails":  emailsVal,
+			"headers": headersVal,
+			"method":  v.Method,
+			"type":    v.DestinationType,
+			"url":     v.Url,
+		})
+
+	return objVal, diags
+}
+
+func (v DestinationValue) Equal(o attr.Value) bool {
+	other, ok := o.(DestinationValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Body.Equal(other.Body) {
+		return false
+	}
+
+	if !v.Channel.Equal(other.Channel) {
+		return false
+	}
+
+	if !v.Emails.Equal(other.Emails) {
+		return false
+	}
+
+	if !v.Headers.Equal(other.Headers) {
+		return false
+	}
+
+	if !v.Method.Equal(other.Method) {
+		return false
+	}
+
+	if !v.DestinationType.Equal(other.DestinationType) {
+		return false
+	}
+
+	if !v.Url.Equal(other.Url) {
+		return false
+	}
+
+	return true
+}
+
+func (v DestinationValue) Type(ctx context.Context) attr.Type {
+	return DestinationType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v DestinationValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"body":    basetypes.StringType{},
+		"channel": basetypes.StringType{},
+		"emails": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"headers": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"method": basetypes.StringType{},
+		"type":   basetypes.StringType{},
+		"url":    basetypes.StringType{},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -103,6 +104,12 @@ func ConsoleConnectorV2ResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"restart_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Arbitrary map of values that, when changed, restarts the connector and its failed tasks, for instance after rotating a secret it reads from a config provider. Not sent to Console.",
+				MarkdownDescription: "Arbitrary map of values that, when changed, restarts the connector and its failed tasks, for instance after rotating a secret it reads from a config provider. Not sent to Console.",
+			},
 			"spec": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"config": schema.MapAttribute{
@@ -122,19 +129,107 @@ func ConsoleConnectorV2ResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Connector specification",
 				MarkdownDescription: "Connector specification",
 			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Desired lifecycle state of the connector, one of `RUNNING`, `PAUSED` or `STOPPED`. The connector is paused, resumed or stopped through Console to match it. `STOPPED` requires Kafka Connect 3.5 or later.",
+				MarkdownDescription: "Desired lifecycle state of the connector, one of `RUNNING`, `PAUSED` or `STOPPED`. The connector is paused, resumed or stopped through Console to match it. `STOPPED` requires Kafka Connect 3.5 or later.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(validation.ValidConnectorStates...),
+				},
+				Default: stringdefault.StaticString("RUNNING"),
+			},
+			"tasks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Task id",
+							MarkdownDescription: "Task id",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Task state, like `RUNNING`, `PAUSED` or `FAILED`",
+							MarkdownDescription: "Task state, like `RUNNING`, `PAUSED` or `FAILED`",
+						},
+						"trace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Stack trace of the task failure, if any",
+							MarkdownDescription: "Stack trace of the task failure, if any",
+						},
+						"worker_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Kafka Connect worker running the task",
+							MarkdownDescription: "Kafka Connect worker running the task",
+						},
+					},
+					CustomType: TasksType{
+						ObjectType: types.ObjectType{
+							AttrTypes: TasksValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "Current status of the connector tasks, as reported by Kafka Connect.",
+				MarkdownDescription: "Current status of the connector tasks, as reported by Kafka Connect.",
+			},
+			"wait_for_running": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"min_running_tasks": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Minimum number of `RUNNING` tasks for the connector to be healthy. When not set, all the connector tasks must be `RUNNING`.",
+						MarkdownDescription: "Minimum number of `RUNNING` tasks for the connector to be healthy. When not set, all the connector tasks must be `RUNNING`.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"poll_interval": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Duration between two reads of the connector status. Defaults to `5s`.",
+						MarkdownDescription: "Duration between two reads of the connector status. Defaults to `5s`.",
+						Validators: []validator.String{
+							validation.Duration(),
+						},
+						Default: stringdefault.StaticString("5s"),
+					},
+					"timeout": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Maximum duration to wait for the connector to be running, like `30s` or `5m`. Defaults to `5m`.",
+						MarkdownDescription: "Maximum duration to wait for the connector to be running, like `30s` or `5m`. Defaults to `5m`.",
+						Validators: []validator.String{
+							validation.Duration(),
+						},
+						Default: stringdefault.StaticString("5m"),
+					},
+				},
+				CustomType: WaitForRunningType{
+					ObjectType: types.ObjectType{
+						AttrTypes: WaitForRunningValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Wait after create and update for the connector and its tasks to be `RUNNING`, failing the apply with the task traces if they are not within `timeout`. Only applies when `state` is `RUNNING`. Not sent to Console.",
+				MarkdownDescription: "Wait after create and update for the connector and its tasks to be `RUNNING`, failing the apply with the task traces if they are not within `timeout`. Only applies when `state` is `RUNNING`. Not sent to Console.",
+			},
 		},
 	}
 }
 
 type ConsoleConnectorV2Model struct {
-	AutoRestart    AutoRestartValue `tfsdk:"auto_restart"`
-	Cluster        types.String     `tfsdk:"cluster"`
-	ConnectCluster types.String     `tfsdk:"connect_cluster"`
-	Description    types.String     `tfsdk:"description"`
-	Labels         types.Map        `tfsdk:"labels"`
-	ManagedLabels  types.Map        `tfsdk:"managed_labels"`
-	Name           types.String     `tfsdk:"name"`
-	Spec           SpecValue        `tfsdk:"spec"`
+	AutoRestart     AutoRestartValue    `tfsdk:"auto_restart"`
+	Cluster         types.String        `tfsdk:"cluster"`
+	ConnectCluster  types.String        `tfsdk:"connect_cluster"`
+	Description     types.String        `tfsdk:"description"`
+	Labels          types.Map           `tfsdk:"labels"`
+	ManagedLabels   types.Map           `tfsdk:"managed_labels"`
+	Name            types.String        `tfsdk:"name"`
+	RestartTriggers types.Map           `tfsdk:"restart_triggers"`
+	Spec            SpecValue           `tfsdk:"spec"`
+	State           types.String        `tfsdk:"state"`
+	Tasks           types.List          `tfsdk:"tasks"`
+	WaitForRunning  WaitForRunningValue `tfsdk:"wait_for_running"`
 }
 
 var _ basetypes.ObjectTypable = AutoRestartType{}
@@ -865,3 +960,926 @@ func (v SpecValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 		},
 	}
 }
+
+var _ basetypes.ObjectTypable = TasksType{}
+
+type TasksType struct {
+	basetypes.ObjectType
+}
+
+func (t TasksType) Equal(o attr.Type) bool {
+	other, ok := o.(TasksType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t TasksType) String() string {
+	return "TasksType"
+}
+
+func (t TasksType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.Int64Value, was: %T`, idAttribute))
+	}
+
+	stateAttribute, ok := attributes["state"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`state is missing from object`)
+
+		return nil, diags
+	}
+
+	stateVal, ok := stateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`state expected to be basetypes.StringValue, was: %T`, stateAttribute))
+	}
+
+	traceAttribute, ok := attributes["trace"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`trace is missing from object`)
+
+		return nil, diags
+	}
+
+	traceVal, ok := traceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`trace expected to be basetypes.StringValue, was: %T`, traceAttribute))
+	}
+
+	workerIdAttribute, ok := attributes["worker_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_id is missing from object`)
+
+		return nil, diags
+	}
+
+	workerIdVal, ok := workerIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_id expected to be basetypes.StringValue, was: %T`, workerIdAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return TasksValue{
+		Id:       idVal,
+		State:    stateVal,
+		Trace:    traceVal,
+		WorkerId: workerIdVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTasksValueNull() TasksValue {
+	return TasksValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewTasksValueUnknown() TasksValue {
+	return TasksValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewTasksValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (TasksValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing TasksValue Attribute Value",
+				"While creating a TasksValue value, a missing attribute value was detected. "+
+					"A TasksValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TasksValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid TasksValue Attribute Type",
+				"While creating a TasksValue value, an invalid attribute value was detected. "+
+					"A TasksValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TasksValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("TasksValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra TasksValue Attribute Value",
+				"While creating a TasksValue value, an extra attribute value was detected. "+
+					"A TasksValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra TasksValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewTasksValueUnknown(), diags
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewTasksValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.Int64Value, was: %T`, idAttribute))
+	}
+
+	stateAttribute, ok := attributes["state"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`state is missing from object`)
+
+		return NewTasksValueUnknown(), diags
+	}
+
+	stateVal, ok := stateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`state expected to be basetypes.StringValue, was: %T`, stateAttribute))
+	}
+
+	traceAttribute, ok := attributes["trace"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`trace is missing from object`)
+
+		return NewTasksValueUnknown(), diags
+	}
+
+	traceVal, ok := traceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`trace expected to be basetypes.StringValue, was: %T`, traceAttribute))
+	}
+
+	workerIdAttribute, ok := attributes["worker_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`worker_id is missing from object`)
+
+		return NewTasksValueUnknown(), diags
+	}
+
+	workerIdVal, ok := workerIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`worker_id expected to be basetypes.StringValue, was: %T`, workerIdAttribute))
+	}
+
+	if diags.HasError() {
+		return NewTasksValueUnknown(), diags
+	}
+
+	return TasksValue{
+		Id:       idVal,
+		State:    stateVal,
+		Trace:    traceVal,
+		WorkerId: workerIdVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTasksValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) TasksValue {
+	object, diags := NewTasksValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewTasksValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t TasksType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewTasksValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewTasksValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewTasksValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewTasksValueMust(TasksValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t TasksType) ValueType(ctx context.Context) attr.Value {
+	return TasksValue{}
+}
+
+var _ basetypes.ObjectValuable = TasksValue{}
+
+type TasksValue struct {
+	Id       basetypes.Int64Value  `tfsdk:"id"`
+	State    basetypes.StringValue `tfsdk:"state"`
+	Trace    basetypes.StringValue `tfsdk:"trace"`
+	WorkerId basetypes.StringValue `tfsdk:"worker_id"`
+	state    attr.ValueState
+}
+
+func (v TasksValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["state"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["trace"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["worker_id"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.State.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["state"] = val
+
+		val, err = v.Trace.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["trace"] = val
+
+		val, err = v.WorkerId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["worker_id"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v TasksValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v TasksValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v TasksValue) String() string {
+	return "TasksValue"
+}
+
+func (v TasksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"id":        basetypes.Int64Type{},
+		"state":     basetypes.StringType{},
+		"trace":     basetypes.StringType{},
+		"worker_id": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"id":        v.Id,
+			"state":     v.State,
+			"trace":     v.Trace,
+			"worker_id": v.WorkerId,
+		})
+
+	return objVal, diags
+}
+
+func (v TasksValue) Equal(o attr.Value) bool {
+	other, ok := o.(TasksValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.State.Equal(other.State) {
+		return false
+	}
+
+	if !v.Trace.Equal(other.Trace) {
+		return false
+	}
+
+	if !v.WorkerId.Equal(other.WorkerId) {
+		return false
+	}
+
+	return true
+}
+
+func (v TasksValue) Type(ctx context.Context) attr.Type {
+	return TasksType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v TasksValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":        basetypes.Int64Type{},
+		"state":     basetypes.StringType{},
+		"trace":     basetypes.StringType{},
+		"worker_id": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = WaitForRunningType{}
+
+type WaitForRunningType struct {
+	basetypes.ObjectType
+}
+
+func (t WaitForRunningType) Equal(o attr.Type) bool {
+	other, ok := o.(WaitForRunningType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t WaitForRunningType) String() string {
+	return "WaitForRunningType"
+}
+
+func (t WaitForRunningType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	minRunningTasksAttribute, ok := attributes["min_running_tasks"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`min_running_tasks is missing from object`)
+
+		return nil, diags
+	}
+
+	minRunningTasksVal, ok := minRunningTasksAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`min_running_tasks expected to be basetypes.Int64Value, was: %T`, minRunningTasksAttribute))
+	}
+
+	pollIntervalAttribute, ok := attributes["poll_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_interval is missing from object`)
+
+		return nil, diags
+	}
+
+	pollIntervalVal, ok := pollIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_interval expected to be basetypes.StringValue, was: %T`, pollIntervalAttribute))
+	}
+
+	timeoutAttribute, ok := attributes["timeout"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timeout is missing from object`)
+
+		return nil, diags
+	}
+
+	timeoutVal, ok := timeoutAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timeout expected to be basetypes.StringValue, was: %T`, timeoutAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return WaitForRunningValue{
+		MinRunningTasks: minRunningTasksVal,
+		PollInterval:    pollIntervalVal,
+		Timeout:         timeoutVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewWaitForRunningValueNull() WaitForRunningValue {
+	return WaitForRunningValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewWaitForRunningValueUnknown() WaitForRunningValue {
+	return WaitForRunningValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewWaitForRunningValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (WaitForRunningValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing WaitForRunningValue Attribute Value",
+				"While creating a WaitForRunningValue value, a missing attribute value was detected. "+
+					"A WaitForRunningValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("WaitForRunningValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid WaitForRunningValue Attribute Type",
+				"While creating a WaitForRunningValue value, an invalid attribute value was detected. "+
+					"A WaitForRunningValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("WaitForRunningValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("WaitForRunningValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra WaitForRunningValue Attribute Value",
+				"While creating a WaitForRunningValue value, an extra attribute value was detected. "+
+					"A WaitForRunningValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra WaitForRunningValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewWaitForRunningValueUnknown(), diags
+	}
+
+	minRunningTasksAttribute, ok := attributes["min_running_tasks"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`min_running_tasks is missing from object`)
+
+		return NewWaitForRunningValueUnknown(), diags
+	}
+
+	minRunningTasksVal, ok := minRunningTasksAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`min_running_tasks expected to be basetypes.Int64Value, was: %T`, minRunningTasksAttribute))
+	}
+
+	pollIntervalAttribute, ok := attributes["poll_interval"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`poll_interval is missing from object`)
+
+		return NewWaitForRunningValueUnknown(), diags
+	}
+
+	pollIntervalVal, ok := pollIntervalAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`poll_interval expected to be basetypes.StringValue, was: %T`, pollIntervalAttribute))
+	}
+
+	timeoutAttribute, ok := attributes["timeout"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timeout is missing from object`)
+
+		return NewWaitForRunningValueUnknown(), diags
+	}
+
+	timeoutVal, ok := timeoutAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timeout expected to be basetypes.StringValue, was: %T`, timeoutAttribute))
+	}
+
+	if diags.HasError() {
+		return NewWaitForRunningValueUnknown(), diags
+	}
+
+	return WaitForRunningValue{
+		MinRunningTasks: minRunningTasksVal,
+		PollInterval:    pollIntervalVal,
+		Timeout:         timeoutVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewWaitForRunningValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) WaitForRunningValue {
+	object, diags := NewWaitForRunningValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewWaitForRunningValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t WaitForRunningType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewWaitForRunningValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewWaitForRunningValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewWaitForRunningValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewWaitForRunningValueMust(WaitForRunningValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t WaitForRunningType) ValueType(ctx context.Context) attr.Value {
+	return WaitForRunningValue{}
+}
+
+var _ basetypes.ObjectValuable = WaitForRunningValue{}
+
+type WaitForRunningValue struct {
+	MinRunningTasks basetypes.Int64Value  `tfsdk:"min_running_tasks"`
+	PollInterval    basetypes.StringValue `tfsdk:"poll_interval"`
+	Timeout         basetypes.StringValue `tfsdk:"timeout"`
+	state           attr.ValueState
+}
+
+func (v WaitForRunningValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["min_running_tasks"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["poll_interval"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["timeout"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.MinRunningTasks.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["min_running_tasks"] = val
+
+		val, err = v.PollInterval.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["poll_interval"] = val
+
+		val, err = v.Timeout.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["timeout"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v WaitForRunningValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v WaitForRunningValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v WaitForRunningValue) String() string {
+	return "WaitForRunningValue"
+}
+
+func (v WaitForRunningValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"min_running_tasks": basetypes.Int64Type{},
+		"poll_interval":     basetypes.StringType{},
+		"timeout":           basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"min_running_tasks": v.MinRunningTasks,
+			"poll_interval":     v.PollInterval,
+			"timeout":           v.Timeout,
+		})
+
+	return objVal, diags
+}
+
+func (v WaitForRunningValue) Equal(o attr.Value) bool {
+	other, ok := o.(WaitForRunningValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.MinRunningTasks.Equal(other.MinRunningTasks) {
+		return false
+	}
+
+	if !v.PollInterval.Equal(other.PollInterval) {
+		return false
+	}
+
+	if !v.Timeout.Equal(other.Timeout) {
+		return false
+	}
+
+	return true
+}
+
+func (v WaitForRunningValue) Type(ctx context.Context) attr.Type {
+	return WaitForRunningType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v WaitForRunningValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"min_running_tasks": basetypes.Int64Type{},
+		"poll_interval":     basetypes.StringType{},
+		"timeout":           basetypes.StringType{},
+	}
+}
@@ -0,0 +1,1134 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_console_data_masking_policy_v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ConsoleDataMaskingPolicyV1ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Data masking policy name, must be unique, acts as an ID for import",
+				MarkdownDescription: "Data masking policy name, must be unique, acts as an ID for import",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-z\\_\\-]+$"), ""),
+				},
+			},
+			"spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"description": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Data masking policy description",
+						MarkdownDescription: "Data masking policy description",
+					},
+					"exempted_groups": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Description:         "Set of Console groups whose members see the fields unmasked. Groups must exist when the policy is applied",
+						MarkdownDescription: "Set of Console groups whose members see the fields unmasked. Groups must exist when the policy is applied",
+						Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+					},
+					"fields": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Required:            true,
+						Description:         "Set of field selectors the policy masks, like `customer.email` for the `email` field of the `customer` object",
+						MarkdownDescription: "Set of field selectors the policy masks, like `customer.email` for the `email` field of the `customer` object",
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"rule": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"masking_char": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Character replacing the masked characters, Console default is `*`",
+								MarkdownDescription: "Character replacing the masked characters, Console default is `*`",
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 1),
+								},
+							},
+							"number_of_chars": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "Number of characters masked, required by `MASK_FIRST_N` and `MASK_LAST_N` rules",
+								MarkdownDescription: "Number of characters masked, required by `MASK_FIRST_N` and `MASK_LAST_N` rules",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"type": schema.StringAttribute{
+								Required:            true,
+								Description:         "Masking rule type, one of `MASK_ALL`, `MASK_FIRST_N` or `MASK_LAST_N`",
+								MarkdownDescription: "Masking rule type, one of `MASK_ALL`, `MASK_FIRST_N` or `MASK_LAST_N`",
+								Validators: []validator.String{
+									stringvalidator.OneOf(validation.ValidDataMaskingRuleTypes...),
+								},
+							},
+						},
+						CustomType: RuleType{
+							ObjectType: types.ObjectType{
+								AttrTypes: RuleValue{}.AttributeTypes(ctx),
+							},
+						},
+						Required:            true,
+						Description:         "Masking rule applied to the fields",
+						MarkdownDescription: "Masking rule applied to the fields",
+					},
+				},
+				CustomType: SpecType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SpecValue{}.AttributeTypes(ctx),
+					},
+				},
+				Required:            true,
+				Description:         "Data masking policy specification",
+				MarkdownDescription: "Data masking policy specification",
+			},
+		},
+	}
+}
+
+type ConsoleDataMaskingPolicyV1Model struct {
+	Name types.String `tfsdk:"name"`
+	Spec SpecValue    `tfsdk:"spec"`
+}
+
+var _ basetypes.ObjectTypable = SpecType{}
+
+type SpecType struct {
+	basetypes.ObjectType
+}
+
+func (t SpecType) Equal(o attr.Type) bool {
+	other, ok := o.(SpecType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SpecType) String() string {
+	return "SpecType"
+}
+
+func (t SpecType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return nil, diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	exemptedGroupsAttribute, ok := attributes["exempted_groups"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exempted_groups is missing from object`)
+
+		return nil, diags
+	}
+
+	exemptedGroupsVal, ok := exemptedGroupsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exempted_groups expected to be basetypes.SetValue, was: %T`, exemptedGroupsAttribute))
+	}
+
+	fieldsAttribute, ok := attributes["fields"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`fields is missing from object`)
+
+		return nil, diags
+	}
+
+	fieldsVal, ok := fieldsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`fields expected to be basetypes.SetValue, was: %T`, fieldsAttribute))
+	}
+
+	ruleAttribute, ok := attributes["rule"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`rule is missing from object`)
+
+		return nil, diags
+	}
+
+	ruleVal, ok := ruleAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`rule expected to be basetypes.ObjectValue, was: %T`, ruleAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SpecValue{
+		Description:    descriptionVal,
+		ExemptedGroups: exemptedGroupsVal,
+		Fields:         fieldsVal,
+		Rule:           ruleVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueNull() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSpecValueUnknown() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSpecValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SpecValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SpecValue Attribute Value",
+				"While creating a SpecValue value, a missing attribute value was detected. "+
+					"A SpecValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SpecValue Attribute Type",
+				"While creating a SpecValue value, an invalid attribute value was detected. "+
+					"A SpecValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SpecValue Attribute Value",
+				"While creating a SpecValue value, an extra attribute value was detected. "+
+					"A SpecValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SpecValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	exemptedGroupsAttribute, ok := attributes["exempted_groups"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exempted_groups is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	exemptedGroupsVal, ok := exemptedGroupsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exempted_groups expected to be basetypes.SetValue, was: %T`, exemptedGroupsAttribute))
+	}
+
+	fieldsAttribute, ok := attributes["fields"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`fields is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	fieldsVal, ok := fieldsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`fields expected to be basetypes.SetValue, was: %T`, fieldsAttribute))
+	}
+
+	ruleAttribute, ok := attributes["rule"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`rule is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	ruleVal, ok := ruleAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`rule expected to be basetypes.ObjectValue, was: %T`, ruleAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	return SpecValue{
+		Description:    descriptionVal,
+		ExemptedGroups: exemptedGroupsVal,
+		Fields:         fieldsVal,
+		Rule:           ruleVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SpecValue {
+	object, diags := NewSpecValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSpecValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SpecType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSpecValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSpecValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSpecValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSpecValueMust(SpecValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SpecType) ValueType(ctx context.Context) attr.Value {
+	return SpecValue{}
+}
+
+var _ basetypes.ObjectValuable = SpecValue{}
+
+type SpecValue struct {
+	Description    basetypes.StringValue `tfsdk:"description"`
+	ExemptedGroups basetypes.SetValue    `tfsdk:"exempted_groups"`
+	Fields         basetypes.SetValue    `tfsdk:"fields"`
+	Rule           basetypes.ObjectValue `tfsdk:"rule"`
+	state          attr.ValueState
+}
+
+func (v SpecValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["description"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["exempted_groups"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["fields"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["rule"] = basetypes.ObjectType{
+		AttrTypes: RuleValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.Description.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["description"] = val
+
+		val, err = v.ExemptedGroups.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["exempted_groups"] = val
+
+		val, err = v.Fields.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["fields"] = val
+
+		val, err = v.Rule.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["rule"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SpecValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SpecValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SpecValue) String() string {
+	return "SpecValue"
+}
+
+func (v SpecValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var ruleVal basetypes.ObjectValue
+
+	if v.Rule.IsNull() {
+		ruleVal = types.ObjectNull(
+			RuleValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Rule.IsUnknown() {
+		ruleVal = types.ObjectUnknown(
+			RuleValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Rule.IsNull() && !v.Rule.IsUnknown() {
+		ruleVal = types.ObjectValueMust(
+			RuleValue{}.AttributeTypes(ctx),
+			v.Rule.Attributes(),
+		)
+	}
+
+	var exemptedGroupsVal basetypes.SetValue
+	switch {
+	case v.ExemptedGroups.IsUnknown():
+		exemptedGroupsVal = types.SetUnknown(types.StringType)
+	case v.ExemptedGroups.IsNull():
+		exemptedGroupsVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		exemptedGroupsVal, d = types.SetValue(types.StringType, v.ExemptedGroups.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"description": basetypes.StringType{},
+			"exempted_groups": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"fields": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"rule": basetypes.ObjectType{
+				AttrTypes: RuleValue{}.AttributeTypes(ctx),
+			},
+		}), diags
+	}
+
+	var fieldsVal basetypes.SetValue
+	switch {
+	case v.Fields.IsUnknown():
+		fieldsVal = types.SetUnknown(types.StringType)
+	case v.Fields.IsNull():
+		fieldsVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		fieldsVal, d = types.SetValue(types.StringType, v.Fields.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"description": basetypes.StringType{},
+			"exempted_groups": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"fields": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"rule": basetypes.ObjectType{
+				AttrTypes: RuleValue{}.AttributeTypes(ctx),
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"description": basetypes.StringType{},
+		"exempted_groups": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"fields": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"rule": basetypes.ObjectType{
+			AttrTypes: RuleValue{}.AttributeTypes(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"description":     v.Description,
+			"exempted_groups": exemptedGroupsVal,
+			"fields":          fieldsVal,
+			"rule":            ruleVal,
+		})
+
+	return objVal, diags
+}
+
+func (v SpecValue) Equal(o attr.Value) bool {
+	other, ok := o.(SpecValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Description.Equal(other.Description) {
+		return false
+	}
+
+	if !v.ExemptedGroups.Equal(other.ExemptedGroups) {
+		return false
+	}
+
+	if !v.Fields.Equal(other.Fields) {
+		return false
+	}
+
+	if !v.Rule.Equal(other.Rule) {
+		return false
+	}
+
+	return true
+}
+
+func (v SpecValue) Type(ctx context.Context) attr.Type {
+	return SpecType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SpecValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"description": basetypes.StringType{},
+		"exempted_groups": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"fields": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"rule": basetypes.ObjectType{
+			AttrTypes: RuleValue{}.AttributeTypes(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = RuleType{}
+
+type RuleType struct {
+	basetypes.ObjectType
+}
+
+func (t RuleType) Equal(o attr.Type) bool {
+	other, ok := o.(RuleType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t RuleType) String() string {
+	return "RuleType"
+}
+
+func (t RuleType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	maskingCharAttribute, ok := attributes["masking_char"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`masking_char is missing from object`)
+
+		return nil, diags
+	}
+
+	maskingCharVal, ok := maskingCharAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`masking_char expected to be basetypes.StringValue, was: %T`, maskingCharAttribute))
+	}
+
+	numberOfCharsAttribute, ok := attributes["number_of_chars"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`number_of_chars is missing from object`)
+
+		return nil, diags
+	}
+
+	numberOfCharsVal, ok := numberOfCharsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`number_of_chars expected to be basetypes.Int64Value, was: %T`, numberOfCharsAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return RuleValue{
+		MaskingChar:   maskingCharVal,
+		NumberOfChars: numberOfCharsVal,
+		RuleType:      typeVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRuleValueNull() RuleValue {
+	return RuleValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewRuleValueUnknown() RuleValue {
+	return RuleValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewRuleValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (RuleValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing RuleValue Attribute Value",
+				"While creating a RuleValue value, a missing attribute value was detected. "+
+					"A RuleValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RuleValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid RuleValue Attribute Type",
+				"While creating a RuleValue value, an invalid attribute value was detected. "+
+					"A RuleValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RuleValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("RuleValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra RuleValue Attribute Value",
+				"While creating a RuleValue value, an extra attribute value was detected. "+
+					"A RuleValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra RuleValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewRuleValueUnknown(), diags
+	}
+
+	maskingCharAttribute, ok := attributes["masking_char"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`masking_char is missing from object`)
+
+		return NewRuleValueUnknown(), diags
+	}
+
+	maskingCharVal, ok := maskingCharAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`masking_char expected to be basetypes.StringValue, was: %T`, maskingCharAttribute))
+	}
+
+	numberOfCharsAttribute, ok := attributes["number_of_chars"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`number_of_chars is missing from object`)
+
+		return NewRuleValueUnknown(), diags
+	}
+
+	numberOfCharsVal, ok := numberOfCharsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`number_of_chars expected to be basetypes.Int64Value, was: %T`, numberOfCharsAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewRuleValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewRuleValueUnknown(), diags
+	}
+
+	return RuleValue{
+		MaskingChar:   maskingCharVal,
+		NumberOfChars: numberOfCharsVal,
+		RuleType:      typeVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRuleValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) RuleValue {
+	object, diags := NewRuleValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewRuleValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t RuleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewRuleValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewRuleValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewRuleValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewRuleValueMust(RuleValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t RuleType) ValueType(ctx context.Context) attr.Value {
+	return RuleValue{}
+}
+
+var _ basetypes.ObjectValuable = RuleValue{}
+
+type RuleValue struct {
+	MaskingChar   basetypes.StringValue `tfsdk:"masking_char"`
+	NumberOfChars basetypes.Int64Value  `tfsdk:"number_of_chars"`
+	RuleType      basetypes.StringValue `tfsdk:"type"`
+	state         attr.ValueState
+}
+
+func (v RuleValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["masking_char"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["number_of_chars"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.MaskingChar.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["masking_char"] = val
+
+		val, err = v.NumberOfChars.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["number_of_chars"] = val
+
+		val, err = v.RuleType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v RuleValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v RuleValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v RuleValue) String() string {
+	return "RuleValue"
+}
+
+func (v RuleValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"masking_char":    basetypes.StringType{},
+		"number_of_chars": basetypes.Int64Type{},
+		"type":            basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"masking_char":    v.MaskingChar,
+			"number_of_chars": v.NumberOfChars,
+			"type":            v.RuleType,
+		})
+
+	return objVal, diags
+}
+
+func (v RuleValue) Equal(o attr.Value) bool {
+	other, ok := o.(RuleValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.MaskingChar.Equal(other.MaskingChar) {
+		return false
+	}
+
+	if !v.NumberOfChars.Equal(other.NumberOfChars) {
+		return false
+	}
+
+	if !v.RuleType.Equal(other.RuleType) {
+		return false
+	}
+
+	return true
+}
+
+func (v RuleValue) Type(ctx context.Context) attr.Type {
+	return RuleType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v RuleValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"masking_char":    basetypes.StringType{},
+		"number_of_chars": basetypes.Int64Type{},
+		"type":            basetypes.StringType{},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -80,10 +81,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 							"aiven": schema.SingleNestedAttribute{
 								Attributes: map[string]schema.Attribute{
 									"api_token": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Aiven API token.",
 										MarkdownDescription: "Aiven API token.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("aiven_api_token_wo")),
+										},
 									},
 									"project": schema.StringAttribute{
 										Required:            true,
@@ -124,10 +128,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 										MarkdownDescription: "Confluent API key.",
 									},
 									"secret": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Confluent API secret.",
 										MarkdownDescription: "Confluent API secret.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("confluent_secret_wo")),
+										},
 									},
 								},
 								CustomType: ConfluentType{
@@ -149,10 +156,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 										Default:             booldefault.StaticBool(false),
 									},
 									"password": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Conduktor Gateway Admin password.",
 										MarkdownDescription: "Conduktor Gateway Admin password.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("gateway_password_wo")),
+										},
 									},
 									"url": schema.StringAttribute{
 										Required:            true,
@@ -221,10 +231,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 											"basic_auth": schema.SingleNestedAttribute{
 												Attributes: map[string]schema.Attribute{
 													"password": schema.StringAttribute{
-														Required:            true,
+														Optional:            true,
 														Sensitive:           true,
 														Description:         "Schema registry basic auth password.",
 														MarkdownDescription: "Schema registry basic auth password.",
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRoot("schema_registry_password_wo")),
+														},
 													},
 													"username": schema.StringAttribute{
 														Required:            true,
@@ -244,10 +257,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 											"bearer_token": schema.SingleNestedAttribute{
 												Attributes: map[string]schema.Attribute{
 													"token": schema.StringAttribute{
-														Required:            true,
+														Optional:            true,
 														Sensitive:           true,
 														Description:         "Schema registry bearer token.",
 														MarkdownDescription: "Schema registry bearer token.",
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRoot("schema_registry_token_wo")),
+														},
 													},
 												},
 												CustomType: BearerTokenType{
@@ -267,10 +283,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 														MarkdownDescription: "Schema registry SSL auth certificate chain PEM.",
 													},
 													"key": schema.StringAttribute{
-														Required:            true,
+														Optional:            true,
 														Sensitive:           true,
 														Description:         "Schema registry SSL auth private key PEM.",
 														MarkdownDescription: "Schema registry SSL auth private key PEM.",
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRoot("schema_registry_ssl_key_wo")),
+														},
 													},
 												},
 												CustomType: SslAuthType{
@@ -330,10 +349,13 @@ func ConsoleKafkaClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 														MarkdownDescription: "Glue Schema registry AWS access key ID.",
 													},
 													"secret_key": schema.StringAttribute{
-														Required:            true,
+														Optional:            true,
 														Sensitive:           true,
 														Description:         "Glue Schema registry AWS secret key.",
 														MarkdownDescription: "Glue Schema registry AWS secret key.",
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRoot("schema_registry_secret_key_wo")),
+														},
 													},
 												},
 												CustomType: CredentialsType{
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -78,10 +79,13 @@ func ConsoleKafkaConnectV2ResourceSchema(ctx context.Context) schema.Schema {
 							"basic_auth": schema.SingleNestedAttribute{
 								Attributes: map[string]schema.Attribute{
 									"password": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Kafka connect server basic auth password.",
 										MarkdownDescription: "Kafka connect server basic auth password.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_password_wo")),
+										},
 									},
 									"username": schema.StringAttribute{
 										Required:            true,
@@ -101,10 +105,13 @@ func ConsoleKafkaConnectV2ResourceSchema(ctx context.Context) schema.Schema {
 							"bearer_token": schema.SingleNestedAttribute{
 								Attributes: map[string]schema.Attribute{
 									"token": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Kafka connect server bearer token.",
 										MarkdownDescription: "Kafka connect server bearer token.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_token_wo")),
+										},
 									},
 								},
 								CustomType: BearerTokenType{
@@ -124,10 +131,13 @@ func ConsoleKafkaConnectV2ResourceSchema(ctx context.Context) schema.Schema {
 										MarkdownDescription: "Kafka connect server mTLS auth certificate chain PEM.",
 									},
 									"key": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "Kafka connect server mTLS auth private key PEM.",
 										MarkdownDescription: "Kafka connect server mTLS auth private key PEM.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_ssl_key_wo")),
+										},
 									},
 								},
 								CustomType: SslAuthType{
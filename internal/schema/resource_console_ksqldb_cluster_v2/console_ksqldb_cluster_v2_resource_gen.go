@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -71,10 +72,13 @@ func ConsoleKsqldbClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 							"basic_auth": schema.SingleNestedAttribute{
 								Attributes: map[string]schema.Attribute{
 									"password": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "KsqlDB cluster basic auth password.",
 										MarkdownDescription: "KsqlDB cluster basic auth password.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_password_wo")),
+										},
 									},
 									"username": schema.StringAttribute{
 										Required:            true,
@@ -94,10 +98,13 @@ func ConsoleKsqldbClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 							"bearer_token": schema.SingleNestedAttribute{
 								Attributes: map[string]schema.Attribute{
 									"token": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "KsqlDB cluster bearer token.",
 										MarkdownDescription: "KsqlDB cluster bearer token.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_token_wo")),
+										},
 									},
 								},
 								CustomType: BearerTokenType{
@@ -117,10 +124,13 @@ func ConsoleKsqldbClusterV2ResourceSchema(ctx context.Context) schema.Schema {
 										MarkdownDescription: "KsqlDB cluster mTLS auth certificate chain PEM.",
 									},
 									"key": schema.StringAttribute{
-										Required:            true,
+										Optional:            true,
 										Sensitive:           true,
 										Description:         "KsqlDB cluster mTLS auth private key PEM.",
 										MarkdownDescription: "KsqlDB cluster mTLS auth private key PEM.",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRoot("security_ssl_key_wo")),
+										},
 									},
 								},
 								CustomType: SslAuthType{
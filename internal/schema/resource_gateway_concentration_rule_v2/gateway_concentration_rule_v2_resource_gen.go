@@ -0,0 +1,1068 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_gateway_concentration_rule_v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func GatewayConcentrationRuleV2ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the concentration rule, must be unique within the virtual cluster, acts as an ID for import",
+				MarkdownDescription: "The name of the concentration rule, must be unique within the virtual cluster, acts as an ID for import",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9_-]{1,100}$"), ""),
+				},
+			},
+			"spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"auto_managed": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Whether the physical topics are created by Gateway if they don't exist. Defaults to false.",
+						MarkdownDescription: "Whether the physical topics are created by Gateway if they don't exist. Defaults to false.",
+						Default:             booldefault.StaticBool(false),
+					},
+					"offset_correctness": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Whether the offsets of the concentrated topics are corrected, for clients relying on contiguous offsets. Defaults to false.",
+						MarkdownDescription: "Whether the offsets of the concentrated topics are corrected, for clients relying on contiguous offsets. Defaults to false.",
+						Default:             booldefault.StaticBool(false),
+					},
+					"pattern": schema.StringAttribute{
+						Required:            true,
+						Description:         "Regular expression matching the names of the topics concentrated by the rule. Patterns of the rules of a virtual cluster must not overlap.",
+						MarkdownDescription: "Regular expression matching the names of the topics concentrated by the rule. Patterns of the rules of a virtual cluster must not overlap.",
+					},
+					"physical_topics": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"compact": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Physical topic concentrating the topics with the compact cleanup policy",
+								MarkdownDescription: "Physical topic concentrating the topics with the compact cleanup policy",
+							},
+							"delete": schema.StringAttribute{
+								Required:            true,
+								Description:         "Physical topic concentrating the topics with the delete cleanup policy",
+								MarkdownDescription: "Physical topic concentrating the topics with the delete cleanup policy",
+							},
+							"delete_compact": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Physical topic concentrating the topics with both the delete and compact cleanup policies",
+								MarkdownDescription: "Physical topic concentrating the topics with both the delete and compact cleanup policies",
+							},
+						},
+						CustomType: PhysicalTopicsType{
+							ObjectType: types.ObjectType{
+								AttrTypes: PhysicalTopicsValue{}.AttributeTypes(ctx),
+							},
+						},
+						Required:            true,
+						Description:         "Physical topics concentrating the matching topics, depending on their cleanup policy",
+						MarkdownDescription: "Physical topics concentrating the matching topics, depending on their cleanup policy",
+					},
+				},
+				CustomType: SpecType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SpecValue{}.AttributeTypes(ctx),
+					},
+				},
+				Required:            true,
+				Description:         "Concentration rule specification",
+				MarkdownDescription: "Concentration rule specification",
+			},
+			"vcluster": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the virtual cluster the concentration rule belongs to. If not provided, the concentration rule will be created in the default passthrough virtual cluster.",
+				MarkdownDescription: "The name of the virtual cluster the concentration rule belongs to. If not provided, the concentration rule will be created in the default passthrough virtual cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[a-zA-Z0-9_-]+$"), ""),
+				},
+				Default: stringdefault.StaticString("passthrough"),
+			},
+		},
+	}
+}
+
+type GatewayConcentrationRuleV2Model struct {
+	Name     types.String `tfsdk:"name"`
+	Spec     SpecValue    `tfsdk:"spec"`
+	Vcluster types.String `tfsdk:"vcluster"`
+}
+
+var _ basetypes.ObjectTypable = SpecType{}
+
+type SpecType struct {
+	basetypes.ObjectType
+}
+
+func (t SpecType) Equal(o attr.Type) bool {
+	other, ok := o.(SpecType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SpecType) String() string {
+	return "SpecType"
+}
+
+func (t SpecType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	autoManagedAttribute, ok := attributes["auto_managed"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`auto_managed is missing from object`)
+
+		return nil, diags
+	}
+
+	autoManagedVal, ok := autoManagedAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`auto_managed expected to be basetypes.BoolValue, was: %T`, autoManagedAttribute))
+	}
+
+	offsetCorrectnessAttribute, ok := attributes["offset_correctness"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`offset_correctness is missing from object`)
+
+		return nil, diags
+	}
+
+	offsetCorrectnessVal, ok := offsetCorrectnessAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`offset_correctness expected to be basetypes.BoolValue, was: %T`, offsetCorrectnessAttribute))
+	}
+
+	patternAttribute, ok := attributes["pattern"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`pattern is missing from object`)
+
+		return nil, diags
+	}
+
+	patternVal, ok := patternAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`pattern expected to be basetypes.StringValue, was: %T`, patternAttribute))
+	}
+
+	physicalTopicsAttribute, ok := attributes["physical_topics"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`physical_topics is missing from object`)
+
+		return nil, diags
+	}
+
+	physicalTopicsVal, ok := physicalTopicsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`physical_topics expected to be basetypes.ObjectValue, was: %T`, physicalTopicsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SpecValue{
+		AutoManaged:       autoManagedVal,
+		OffsetCorrectness: offsetCorrectnessVal,
+		Pattern:           patternVal,
+		PhysicalTopics:    physicalTopicsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueNull() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSpecValueUnknown() SpecValue {
+	return SpecValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSpecValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SpecValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SpecValue Attribute Value",
+				"While creating a SpecValue value, a missing attribute value was detected. "+
+					"A SpecValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SpecValue Attribute Type",
+				"While creating a SpecValue value, an invalid attribute value was detected. "+
+					"A SpecValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SpecValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SpecValue Attribute Value",
+				"While creating a SpecValue value, an extra attribute value was detected. "+
+					"A SpecValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SpecValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	autoManagedAttribute, ok := attributes["auto_managed"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`auto_managed is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	autoManagedVal, ok := autoManagedAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`auto_managed expected to be basetypes.BoolValue, was: %T`, autoManagedAttribute))
+	}
+
+	offsetCorrectnessAttribute, ok := attributes["offset_correctness"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`offset_correctness is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	offsetCorrectnessVal, ok := offsetCorrectnessAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`offset_correctness expected to be basetypes.BoolValue, was: %T`, offsetCorrectnessAttribute))
+	}
+
+	patternAttribute, ok := attributes["pattern"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`pattern is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	patternVal, ok := patternAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`pattern expected to be basetypes.StringValue, was: %T`, patternAttribute))
+	}
+
+	physicalTopicsAttribute, ok := attributes["physical_topics"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`physical_topics is missing from object`)
+
+		return NewSpecValueUnknown(), diags
+	}
+
+	physicalTopicsVal, ok := physicalTopicsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`physical_topics expected to be basetypes.ObjectValue, was: %T`, physicalTopicsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSpecValueUnknown(), diags
+	}
+
+	return SpecValue{
+		AutoManaged:       autoManagedVal,
+		OffsetCorrectness: offsetCorrectnessVal,
+		Pattern:           patternVal,
+		PhysicalTopics:    physicalTopicsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSpecValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SpecValue {
+	object, diags := NewSpecValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSpecValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SpecType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSpecValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSpecValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSpecValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSpecValueMust(SpecValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SpecType) ValueType(ctx context.Context) attr.Value {
+	return SpecValue{}
+}
+
+var _ basetypes.ObjectValuable = SpecValue{}
+
+type SpecValue struct {
+	AutoManaged       basetypes.BoolValue   `tfsdk:"auto_managed"`
+	OffsetCorrectness basetypes.BoolValue   `tfsdk:"offset_correctness"`
+	Pattern           basetypes.StringValue `tfsdk:"pattern"`
+	PhysicalTopics    basetypes.ObjectValue `tfsdk:"physical_topics"`
+	state             attr.ValueState
+}
+
+func (v SpecValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["auto_managed"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["offset_correctness"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["pattern"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["physical_topics"] = basetypes.ObjectType{
+		AttrTypes: PhysicalTopicsValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.AutoManaged.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["auto_managed"] = val
+
+		val, err = v.OffsetCorrectness.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["offset_correctness"] = val
+
+		val, err = v.Pattern.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["pattern"] = val
+
+		val, err = v.PhysicalTopics.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["physical_topics"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SpecValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SpecValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SpecValue) String() string {
+	return "SpecValue"
+}
+
+func (v SpecValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var physicalTopicsVal basetypes.ObjectValue
+
+	if v.PhysicalTopics.IsNull() {
+		physicalTopicsVal = types.ObjectNull(
+			PhysicalTopicsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.PhysicalTopics.IsUnknown() {
+		physicalTopicsVal = types.ObjectUnknown(
+			PhysicalTopicsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.PhysicalTopics.IsNull() && !v.PhysicalTopics.IsUnknown() {
+		physicalTopicsVal = types.ObjectValueMust(
+			PhysicalTopicsValue{}.AttributeTypes(ctx),
+			v.PhysicalTopics.Attributes(),
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"auto_managed":       basetypes.BoolType{},
+		"offset_correctness": basetypes.BoolType{},
+		"pattern":            basetypes.StringType{},
+		"physical_topics": basetypes.ObjectType{
+			AttrTypes: PhysicalTopicsValue{}.AttributeTypes(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"auto_managed":       v.AutoManaged,
+			"offset_correctness": v.OffsetCorrectness,
+			"pattern":            v.Pattern,
+			"physical_topics":    physicalTopicsVal,
+		})
+
+	return objVal, diags
+}
+
+func (v SpecValue) Equal(o attr.Value) bool {
+	other, ok := o.(SpecValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.AutoManaged.Equal(other.AutoManaged) {
+		return false
+	}
+
+	if !v.OffsetCorrectness.Equal(other.OffsetCorrectness) {
+		return false
+	}
+
+	if !v.Pattern.Equal(other.Pattern) {
+		return false
+	}
+
+	if !v.PhysicalTopics.Equal(other.PhysicalTopics) {
+		return false
+	}
+
+	return true
+}
+
+func (v SpecValue) Type(ctx context.Context) attr.Type {
+	return SpecType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SpecValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"auto_managed":       basetypes.BoolType{},
+		"offset_correctness": basetypes.BoolType{},
+		"pattern":            basetypes.StringType{},
+		"physical_topics": basetypes.ObjectType{
+			AttrTypes: PhysicalTopicsValue{}.AttributeTypes(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = PhysicalTopicsType{}
+
+type PhysicalTopicsType struct {
+	basetypes.ObjectType
+}
+
+func (t PhysicalTopicsType) Equal(o attr.Type) bool {
+	other, ok := o.(PhysicalTopicsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t PhysicalTopicsType) String() string {
+	return "PhysicalTopicsType"
+}
+
+func (t PhysicalTopicsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	compactAttribute, ok := attributes["compact"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`compact is missing from object`)
+
+		return nil, diags
+	}
+
+	compactVal, ok := compactAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`compact expected to be basetypes.StringValue, was: %T`, compactAttribute))
+	}
+
+	deleteAttribute, ok := attributes["delete"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete is missing from object`)
+
+		return nil, diags
+	}
+
+	deleteVal, ok := deleteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete expected to be basetypes.StringValue, was: %T`, deleteAttribute))
+	}
+
+	deleteCompactAttribute, ok := attributes["delete_compact"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete_compact is missing from object`)
+
+		return nil, diags
+	}
+
+	deleteCompactVal, ok := deleteCompactAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete_compact expected to be basetypes.StringValue, was: %T`, deleteCompactAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return PhysicalTopicsValue{
+		Compact:       compactVal,
+		Delete:        deleteVal,
+		DeleteCompact: deleteCompactVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPhysicalTopicsValueNull() PhysicalTopicsValue {
+	return PhysicalTopicsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewPhysicalTopicsValueUnknown() PhysicalTopicsValue {
+	return PhysicalTopicsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewPhysicalTopicsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PhysicalTopicsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing PhysicalTopicsValue Attribute Value",
+				"While creating a PhysicalTopicsValue value, a missing attribute value was detected. "+
+					"A PhysicalTopicsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PhysicalTopicsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid PhysicalTopicsValue Attribute Type",
+				"While creating a PhysicalTopicsValue value, an invalid attribute value was detected. "+
+					"A PhysicalTopicsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PhysicalTopicsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PhysicalTopicsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra PhysicalTopicsValue Attribute Value",
+				"While creating a PhysicalTopicsValue value, an extra attribute value was detected. "+
+					"A PhysicalTopicsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra PhysicalTopicsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewPhysicalTopicsValueUnknown(), diags
+	}
+
+	compactAttribute, ok := attributes["compact"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`compact is missing from object`)
+
+		return NewPhysicalTopicsValueUnknown(), diags
+	}
+
+	compactVal, ok := compactAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`compact expected to be basetypes.StringValue, was: %T`, compactAttribute))
+	}
+
+	deleteAttribute, ok := attributes["delete"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete is missing from object`)
+
+		return NewPhysicalTopicsValueUnknown(), diags
+	}
+
+	deleteVal, ok := deleteAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete expected to be basetypes.StringValue, was: %T`, deleteAttribute))
+	}
+
+	deleteCompactAttribute, ok := attributes["delete_compact"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`delete_compact is missing from object`)
+
+		return NewPhysicalTopicsValueUnknown(), diags
+	}
+
+	deleteCompactVal, ok := deleteCompactAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`delete_compact expected to be basetypes.StringValue, was: %T`, deleteCompactAttribute))
+	}
+
+	if diags.HasError() {
+		return NewPhysicalTopicsValueUnknown(), diags
+	}
+
+	return PhysicalTopicsValue{
+		Compact:       compactVal,
+		Delete:        deleteVal,
+		DeleteCompact: deleteCompactVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPhysicalTopicsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PhysicalTopicsValue {
+	object, diags := NewPhysicalTopicsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewPhysicalTopicsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t PhysicalTopicsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewPhysicalTopicsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewPhysicalTopicsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewPhysicalTopicsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewPhysicalTopicsValueMust(PhysicalTopicsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t PhysicalTopicsType) ValueType(ctx context.Context) attr.Value {
+	return PhysicalTopicsValue{}
+}
+
+var _ basetypes.ObjectValuable = PhysicalTopicsValue{}
+
+type PhysicalTopicsValue struct {
+	Compact       basetypes.StringValue `tfsdk:"compact"`
+	Delete        basetypes.StringValue `tfsdk:"delete"`
+	DeleteCompact basetypes.StringValue `tfsdk:"delete_compact"`
+	state         attr.ValueState
+}
+
+func (v PhysicalTopicsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["compact"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["delete"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["delete_compact"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 3)
+
+		val, err = v.Compact.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["compact"] = val
+
+		val, err = v.Delete.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["delete"] = val
+
+		val, err = v.DeleteCompact.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["delete_compact"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v PhysicalTopicsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v PhysicalTopicsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v PhysicalTopicsValue) String() string {
+	return "PhysicalTopicsValue"
+}
+
+func (v PhysicalTopicsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"compact":        basetypes.StringType{},
+		"delete":         basetypes.StringType{},
+		"delete_compact": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"compact":        v.Compact,
+			"delete":         v.Delete,
+			"delete_compact": v.DeleteCompact,
+		})
+
+	return objVal, diags
+}
+
+func (v PhysicalTopicsValue) Equal(o attr.Value) bool {
+	other, ok := o.(PhysicalTopicsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Compact.Equal(other.Compact) {
+		return false
+	}
+
+	if !v.Delete.Equal(other.Delete) {
+		return false
+	}
+
+	if !v.DeleteCompact.Equal(other.DeleteCompact) {
+		return false
+	}
+
+	return true
+}
+
+func (v PhysicalTopicsValue) Type(ctx context.Context) attr.Type {
+	return PhysicalTopicsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v PhysicalTopicsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"compact":        basetypes.StringType{},
+		"delete":         basetypes.StringType{},
+		"delete_compact": basetypes.StringType{},
+	}
+}
@@ -4,6 +4,7 @@ package resource_gateway_token_v2
 
 import (
 	"context"
+	"github.com/conduktor/terraform-provider-conduktor/internal/schema/validation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -20,6 +21,22 @@ import (
 func GatewayTokenV2ResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Expiration time of the token, in RFC3339 format.",
+				MarkdownDescription: "Expiration time of the token, in RFC3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issued_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Issue time of the token, in RFC3339 format.",
+				MarkdownDescription: "Issue time of the token, in RFC3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"lifetime_seconds": schema.Int64Attribute{
 				Required:            true,
 				Description:         "The life time of the token in milliseconds.",
@@ -31,12 +48,21 @@ func GatewayTokenV2ResourceSchema(ctx context.Context) schema.Schema {
 					int64validator.Between(1, 2147483647),
 				},
 			},
+			"rotate_before": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Duration before the token expiration from which a new token is planned, e.g. `24h`. Duration format is a sequence of decimal numbers followed by a unit suffix: `s`, `m` or `h`. If not set, a new token is only issued once the current one has expired.",
+				MarkdownDescription: "Duration before the token expiration from which a new token is planned, e.g. `24h`. Duration format is a sequence of decimal numbers followed by a unit suffix: `s`, `m` or `h`. If not set, a new token is only issued once the current one has expired.",
+				Validators: []validator.String{
+					validation.Duration(),
+				},
+			},
 			"token": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
 				Description:         "Response token.",
 				MarkdownDescription: "Response token.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -66,7 +92,10 @@ func GatewayTokenV2ResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type GatewayTokenV2Model struct {
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+	IssuedAt        types.String `tfsdk:"issued_at"`
 	LifetimeSeconds types.Int64  `tfsdk:"lifetime_seconds"`
+	RotateBefore    types.String `tfsdk:"rotate_before"`
 	Token           types.String `tfsdk:"token"`
 	Username        types.String `tfsdk:"username"`
 	Vcluster        types.String `tfsdk:"vcluster"`
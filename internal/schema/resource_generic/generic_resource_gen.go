@@ -34,8 +34,8 @@ func GenericResourceSchema(ctx context.Context) schema.Schema {
 			"manifest": schema.StringAttribute{
 				CustomType:          customtypes.YAMLNormalizedType{},
 				Required:            true,
-				Description:         "Resource manifest in yaml format. Use `yamlencode`/`yamldecode` function to normalize input and avoid dirty plan. See [reference documentation](https://docs.conduktor.io/platform/reference/resource-reference/console/#manifests) for more details",
-				MarkdownDescription: "Resource manifest in yaml format. Use `yamlencode`/`yamldecode` function to normalize input and avoid dirty plan. See [reference documentation](https://docs.conduktor.io/platform/reference/resource-reference/console/#manifests) for more details",
+				Description:         "Resource manifest in yaml format. Use `yamlencode`/`yamldecode` function to normalize input and avoid dirty plan. May contain several documents separated by `---`, applied in dependency order and all tracked by this resource, `kind`, `name` and `version` then describing the main one. See [reference documentation](https://docs.conduktor.io/platform/reference/resource-reference/console/#manifests) for more details",
+				MarkdownDescription: "Resource manifest in yaml format. Use `yamlencode`/`yamldecode` function to normalize input and avoid dirty plan. May contain several documents separated by `---`, applied in dependency order and all tracked by this resource, `kind`, `name` and `version` then describing the main one. See [reference documentation](https://docs.conduktor.io/platform/reference/resource-reference/console/#manifests) for more details",
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
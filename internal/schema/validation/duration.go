@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+)
+
+var _ validator.String = duration{}
+
+// duration validates that a string Attribute is a positive Go duration.
+type duration struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator duration) Description(_ context.Context) string {
+	return "string must be a positive duration like \"30s\", \"5m\" or \"1h30m\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator duration) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v duration) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+		return
+	}
+}
+
+// Duration returns a validator which ensures that any configured
+// attribute value is a positive duration parsable by time.ParseDuration. Null (unconfigured)
+// and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return duration{}
+}
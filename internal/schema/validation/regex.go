@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+)
+
+var _ validator.String = regex{}
+
+// regex validates that a string Attribute is a valid regular expression.
+type regex struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator regex) Description(_ context.Context) string {
+	return "string must be a valid regular expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator regex) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v regex) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := regexp.Compile(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q: %s", value, err),
+		))
+		return
+	}
+}
+
+// Regex returns a validator which ensures that any configured
+// attribute value is a regular expression parsable by regexp.Compile. Null (unconfigured)
+// and unknown (known after apply) values are skipped.
+func Regex() validator.String {
+	return regex{}
+}
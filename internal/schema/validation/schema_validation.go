@@ -96,6 +96,18 @@ var ValidResourcePolicyTargetKind = []string{"ApplicationGroup", "Connector", "S
 
 var ValidApplicationInstancePermission = []string{"NONE", "READ", "WRITE"}
 
+// Console Alert.
+var ValidAlertTypes = []string{"BrokerAlert", "ConsumerGroupAlert", "KafkaConnectAlert", "TopicAlert"}
+var ValidAlertOperators = []string{"GreaterThan", "GreaterThanOrEqual", "LessThan", "LessThanOrEqual", "NotEqual"}
+var ValidAlertDestinationTypes = []string{"Email", "Slack", "Teams", "Webhook"}
+var ValidAlertWebhookMethods = []string{"DELETE", "GET", "PATCH", "POST", "PUT"}
+
+// Console Data Masking Policy.
+var ValidDataMaskingRuleTypes = []string{"MASK_ALL", "MASK_FIRST_N", "MASK_LAST_N"}
+
+// Console Connector.
+var ValidConnectorStates = []string{"RUNNING", "PAUSED", "STOPPED"}
+
 var ValidPartnerZoneAuthenticationType = []string{"MTLS", "OAUTHBEARER", "PLAIN"}
 var ValidPartnerZoneTopicPermission = []string{"READ", "WRITE"}
 
@@ -0,0 +1,157 @@
+package schemacompat
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hamba/avro/v2"
+)
+
+// Writer types each reader type can be promoted from, as defined by the Avro schema resolution rules.
+var avroPromotions = map[avro.Type][]avro.Type{
+	avro.Long:   {avro.Int},
+	avro.Float:  {avro.Int, avro.Long},
+	avro.Double: {avro.Int, avro.Long, avro.Float},
+	avro.String: {avro.Bytes},
+	avro.Bytes:  {avro.String},
+}
+
+// checkAvro follows the Avro schema resolution rules to check that data written with the writer schema can be read
+// with the reader schema.
+func checkAvro(reader, writer string, d direction) ([]Incompatibility, error) {
+	// Each schema gets its own cache as both versions usually declare the same named types.
+	readerSchema, err := avro.ParseWithCache(reader, "", &avro.SchemaCache{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s AVRO schema: %w", d.reader, err)
+	}
+	writerSchema, err := avro.ParseWithCache(writer, "", &avro.SchemaCache{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s AVRO schema: %w", d.writer, err)
+	}
+
+	c := avroChecker{direction: d, visited: map[string]bool{}}
+	c.check("", readerSchema, writerSchema)
+	return c.incompatibilities, nil
+}
+
+type avroChecker struct {
+	direction         direction
+	incompatibilities []Incompatibility
+	// Pairs of records already checked, to stop on recursive types.
+	visited map[string]bool
+}
+
+func (c *avroChecker) report(path, message string) {
+	c.incompatibilities = append(c.incompatibilities, Incompatibility{Path: path, Message: message})
+}
+
+func (c *avroChecker) check(path string, reader, writer avro.Schema) {
+	reader, writer = avroDeref(reader), avroDeref(writer)
+
+	// Every branch of a writer union must be readable.
+	if writerUnion, ok := writer.(*avro.UnionSchema); ok {
+		for _, branch := range writerUnion.Types() {
+			c.check(path, reader, branch)
+		}
+		return
+	}
+
+	if readerUnion, ok := reader.(*avro.UnionSchema); ok {
+		for _, branch := range readerUnion.Types() {
+			if avroMatches(avroDeref(branch), writer) {
+				c.check(path, branch, writer)
+				return
+			}
+		}
+		c.report(path, fmt.Sprintf("type %s of the %s schema is not part of the union of the %s schema", avroTypeName(writer), c.direction.writer, c.direction.reader))
+		return
+	}
+
+	if !avroMatches(reader, writer) {
+		c.report(path, c.direction.changed("type", avroTypeName(reader), avroTypeName(writer)))
+		return
+	}
+
+	switch r := reader.(type) {
+	case *avro.RecordSchema:
+		c.checkRecord(path, r, writer.(*avro.RecordSchema))
+	case *avro.EnumSchema:
+		w := writer.(*avro.EnumSchema)
+		if r.HasDefault() {
+			return
+		}
+		for _, symbol := range w.Symbols() {
+			if !slices.Contains(r.Symbols(), symbol) {
+				c.report(path, fmt.Sprintf("enum symbol %s of the %s schema is missing from the %s schema, which has no default symbol", symbol, c.direction.writer, c.direction.reader))
+			}
+		}
+	case *avro.FixedSchema:
+		w := writer.(*avro.FixedSchema)
+		if r.Size() != w.Size() {
+			c.report(path, c.direction.changed("fixed size", fmt.Sprint(r.Size()), fmt.Sprint(w.Size())))
+		}
+	case *avro.ArraySchema:
+		c.check(path, r.Items(), writer.(*avro.ArraySchema).Items())
+	case *avro.MapSchema:
+		c.check(path, r.Values(), writer.(*avro.MapSchema).Values())
+	}
+}
+
+func (c *avroChecker) checkRecord(path string, reader, writer *avro.RecordSchema) {
+	key := reader.FullName() + "|" + writer.FullName()
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+
+	for _, field := range reader.Fields() {
+		fieldPath := joinPath(path, field.Name())
+		writerField := avroField(writer, field)
+		if writerField == nil {
+			if !field.HasDefault() {
+				c.report(fieldPath, fmt.Sprintf("field is missing from the %s schema and has no default value in the %s schema", c.direction.writer, c.direction.reader))
+			}
+			continue
+		}
+		c.check(fieldPath, field.Type(), writerField.Type())
+	}
+}
+
+// avroField returns the field of the writer record matching the given reader field by name or alias.
+func avroField(writer *avro.RecordSchema, field *avro.Field) *avro.Field {
+	for _, f := range writer.Fields() {
+		if f.Name() == field.Name() || slices.Contains(field.Aliases(), f.Name()) {
+			return f
+		}
+	}
+	return nil
+}
+
+// avroMatches returns whether the reader schema can resolve the writer schema at its top level, without recursing
+// into fields and items.
+func avroMatches(reader, writer avro.Schema) bool {
+	if reader.Type() != writer.Type() {
+		return slices.Contains(avroPromotions[reader.Type()], writer.Type())
+	}
+	// Named types must keep their name, unless the reader declares the writer one as alias.
+	if r, ok := reader.(avro.NamedSchema); ok {
+		w := writer.(avro.NamedSchema)
+		return r.Name() == w.Name() || r.FullName() == w.FullName() || slices.Contains(r.Aliases(), w.FullName())
+	}
+	return true
+}
+
+// avroDeref returns the schema a reference points to, to compare named types declared earlier in the schema.
+func avroDeref(s avro.Schema) avro.Schema {
+	if ref, ok := s.(*avro.RefSchema); ok {
+		return ref.Schema()
+	}
+	return s
+}
+
+func avroTypeName(s avro.Schema) string {
+	if named, ok := s.(avro.NamedSchema); ok {
+		return fmt.Sprintf("%s %s", s.Type(), named.FullName())
+	}
+	return string(s.Type())
+}
@@ -0,0 +1,127 @@
+// Package schemacompat implements local compatibility checks between two versions of a schema registry schema,
+// following the rules of the Avro, Protobuf and JSON Schema compatibility modes of the schema registry.
+package schemacompat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Schema formats supported by the checks.
+const (
+	FormatAvro     = "AVRO"
+	FormatProtobuf = "PROTOBUF"
+	FormatJSON     = "JSON"
+)
+
+// Compatibility modes supported by the checks.
+const (
+	ModeNone               = "NONE"
+	ModeBackward           = "BACKWARD"
+	ModeBackwardTransitive = "BACKWARD_TRANSITIVE"
+	ModeForward            = "FORWARD"
+	ModeForwardTransitive  = "FORWARD_TRANSITIVE"
+	ModeFull               = "FULL"
+	ModeFullTransitive     = "FULL_TRANSITIVE"
+)
+
+// Incompatibility is a breaking change found between two versions of a schema.
+type Incompatibility struct {
+	// Path of the field the change applies to, dot separated from the root of the schema.
+	// Empty when the change applies to the whole schema.
+	Path    string
+	Message string
+}
+
+func (i Incompatibility) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// checker reports the changes preventing data written with the writer schema to be read with the reader schema.
+type checker func(reader, writer string, d direction) ([]Incompatibility, error)
+
+// direction names the versions of the schema used as reader and writer of a check, for messages to always describe
+// the change from the previous version to the new one.
+type direction struct {
+	reader string
+	writer string
+}
+
+var (
+	backward = direction{reader: "new", writer: "previous"}
+	forward  = direction{reader: "previous", writer: "new"}
+)
+
+// changed describes a change between a reader and a writer value, from the previous version to the new one.
+func (d direction) changed(what, readerValue, writerValue string) string {
+	if d == backward {
+		return fmt.Sprintf("%s changed from %s to %s", what, writerValue, readerValue)
+	}
+	return fmt.Sprintf("%s changed from %s to %s", what, readerValue, writerValue)
+}
+
+// Check returns the incompatibilities between the previous and the next version of a schema for the given format
+// under the given compatibility mode.
+// Transitive modes are checked against the previous version only, as older versions are not known locally.
+// An error is returned if the format or mode is not supported, or if any of the schemas can't be parsed.
+func Check(format, mode, previous, next string) ([]Incompatibility, error) {
+	var check checker
+	switch strings.ToUpper(format) {
+	case FormatAvro:
+		check = checkAvro
+	case FormatProtobuf:
+		check = checkProtobuf
+	case FormatJSON:
+		check = checkJSON
+	default:
+		return nil, fmt.Errorf("unsupported schema format %q", format)
+	}
+
+	switch strings.ToUpper(mode) {
+	case ModeNone:
+		return nil, nil
+	case ModeBackward, ModeBackwardTransitive:
+		// Consumers using the next schema must be able to read data written with the previous one.
+		return check(next, previous, backward)
+	case ModeForward, ModeForwardTransitive:
+		// Consumers using the previous schema must be able to read data written with the next one.
+		return check(previous, next, forward)
+	case ModeFull, ModeFullTransitive:
+		backwardIssues, err := check(next, previous, backward)
+		if err != nil {
+			return nil, err
+		}
+		forwardIssues, err := check(previous, next, forward)
+		if err != nil {
+			return nil, err
+		}
+		return dedup(append(backwardIssues, forwardIssues...)), nil
+	default:
+		return nil, fmt.Errorf("unsupported compatibility mode %q", mode)
+	}
+}
+
+// dedup removes the duplicated incompatibilities, reported in both directions of a full compatibility check.
+func dedup(incompatibilities []Incompatibility) []Incompatibility {
+	seen := make(map[Incompatibility]bool, len(incompatibilities))
+	result := make([]Incompatibility, 0, len(incompatibilities))
+	for _, i := range incompatibilities {
+		if seen[i] {
+			continue
+		}
+		seen[i] = true
+		result = append(result, i)
+	}
+	return result
+}
+
+// joinPath appends a field name to a dot separated path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,312 @@
+package schemacompat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const avroUser = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": "int"},
+    {"name": "name", "type": "string"},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE", "INACTIVE"]}},
+    {"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "city", "type": "string"}]}}
+  ]
+}`
+
+const protobufUser = `syntax = "proto3";
+package com.example;
+
+message User {
+  int32 id = 1;
+  string name = 2;
+  repeated string emails = 3;
+  Address address = 4;
+  oneof contact {
+    string phone = 5;
+  }
+
+  message Address {
+    string city = 1;
+  }
+}`
+
+const jsonUser = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "integer"},
+    "name": {"type": "string", "maxLength": 100},
+    "status": {"type": "string", "enum": ["ACTIVE", "INACTIVE"]},
+    "address": {"type": "object", "properties": {"city": {"type": "string"}}}
+  },
+  "required": ["id"]
+}`
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		mode     string
+		previous string
+		next     string
+		expected []Incompatibility
+	}{
+		{
+			name:     "avro unchanged",
+			format:   FormatAvro,
+			mode:     ModeFullTransitive,
+			previous: avroUser,
+			next:     avroUser,
+		},
+		{
+			name:     "avro field added with default is backward compatible",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "int"}, {"name": "age", "type": "int", "default": 0}`),
+		},
+		{
+			name:     "avro field added without default is not backward compatible",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "int"}, {"name": "age", "type": "int"}`),
+			expected: []Incompatibility{{Path: "age", Message: "field is missing from the previous schema and has no default value in the new schema"}},
+		},
+		{
+			name:     "avro field added without default is forward compatible",
+			format:   FormatAvro,
+			mode:     ModeForward,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "int"}, {"name": "age", "type": "int"}`),
+		},
+		{
+			name:     "avro nested field removed is not forward compatible",
+			format:   FormatAvro,
+			mode:     ModeForwardTransitive,
+			previous: avroUser,
+			next:     replace(avroUser, `[{"name": "city", "type": "string"}]`, `[]`),
+			expected: []Incompatibility{{Path: "address.city", Message: "field is missing from the new schema and has no default value in the previous schema"}},
+		},
+		{
+			name:     "avro type promotion is backward but not forward compatible",
+			format:   FormatAvro,
+			mode:     ModeFull,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "long"}`),
+			expected: []Incompatibility{{Path: "id", Message: "type changed from int to long"}},
+		},
+		{
+			name:     "avro type change",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "name", "type": "string"}`, `{"name": "name", "type": "int"}`),
+			expected: []Incompatibility{{Path: "name", Message: "type changed from string to int"}},
+		},
+		{
+			name:     "avro field made nullable is backward compatible",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `{"name": "name", "type": "string"}`, `{"name": "name", "type": ["null", "string"]}`),
+		},
+		{
+			name:     "avro nullable field made required is not backward compatible",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: replace(avroUser, `{"name": "name", "type": "string"}`, `{"name": "name", "type": ["null", "string"]}`),
+			next:     avroUser,
+			expected: []Incompatibility{{Path: "name", Message: "type changed from null to string"}},
+		},
+		{
+			name:     "avro enum symbol removed",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `["ACTIVE", "INACTIVE"]`, `["ACTIVE"]`),
+			expected: []Incompatibility{{Path: "status", Message: "enum symbol INACTIVE of the previous schema is missing from the new schema, which has no default symbol"}},
+		},
+		{
+			name:     "avro record renamed",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `"name": "Address"`, `"name": "Location"`),
+			expected: []Incompatibility{{Path: "address", Message: "type changed from record com.example.Address to record com.example.Location"}},
+		},
+		{
+			name:     "avro record renamed with alias",
+			format:   FormatAvro,
+			mode:     ModeBackward,
+			previous: avroUser,
+			next:     replace(avroUser, `"name": "Address"`, `"name": "Location", "aliases": ["Address"]`),
+		},
+		{
+			name:     "avro breaking change ignored with NONE",
+			format:   FormatAvro,
+			mode:     ModeNone,
+			previous: avroUser,
+			next:     `"string"`,
+		},
+		{
+			name:     "protobuf unchanged",
+			format:   FormatProtobuf,
+			mode:     ModeFull,
+			previous: protobufUser,
+			next:     protobufUser,
+		},
+		{
+			name:     "protobuf field renamed and added",
+			format:   FormatProtobuf,
+			mode:     ModeFull,
+			previous: protobufUser,
+			next:     replace(replace(protobufUser, "string name = 2;", "string full_name = 2;\n  int64 age = 6;"), "int32 id = 1;", "int64 id = 1;"),
+		},
+		{
+			name:     "protobuf field type changed",
+			format:   FormatProtobuf,
+			mode:     ModeBackward,
+			previous: protobufUser,
+			next:     replace(protobufUser, "string name = 2;", "int32 name = 2;"),
+			expected: []Incompatibility{{Path: "User.name", Message: "type changed from string to int32"}},
+		},
+		{
+			name:     "protobuf nested field type changed",
+			format:   FormatProtobuf,
+			mode:     ModeForward,
+			previous: protobufUser,
+			next:     replace(protobufUser, "string city = 1;", "double city = 1;"),
+			expected: []Incompatibility{{Path: "User.Address.city", Message: "type changed from string to double"}},
+		},
+		{
+			name:     "protobuf field made repeated",
+			format:   FormatProtobuf,
+			mode:     ModeBackward,
+			previous: protobufUser,
+			next:     replace(protobufUser, "string name = 2;", "repeated string name = 2;"),
+			expected: []Incompatibility{{Path: "User.name", Message: "label changed from none to repeated"}},
+		},
+		{
+			name:     "protobuf field moved to existing oneof",
+			format:   FormatProtobuf,
+			mode:     ModeFull,
+			previous: protobufUser,
+			next:     replace(replace(protobufUser, "string name = 2;\n", ""), "string phone = 5;", "string phone = 5;\n    string name = 2;"),
+			expected: []Incompatibility{{Path: "User.name", Message: "field moved to the existing oneof contact"}},
+		},
+		{
+			name:     "protobuf message and package removed",
+			format:   FormatProtobuf,
+			mode:     ModeBackward,
+			previous: protobufUser,
+			next:     "syntax = \"proto3\";\nmessage Account {\n  int32 id = 1;\n}",
+			expected: []Incompatibility{
+				{Message: "package changed from com.example to none"},
+				{Path: "User", Message: "message of the previous schema is missing from the new schema"},
+				{Path: "User.Address", Message: "message of the previous schema is missing from the new schema"},
+			},
+		},
+		{
+			name:     "json unchanged",
+			format:   FormatJSON,
+			mode:     ModeFull,
+			previous: jsonUser,
+			next:     jsonUser,
+		},
+		{
+			name:     "json optional property added",
+			format:   FormatJSON,
+			mode:     ModeFull,
+			previous: jsonUser,
+			next:     replace(jsonUser, `"id": {"type": "integer"},`, `"id": {"type": "integer"}, "age": {"type": "integer"},`),
+		},
+		{
+			name:     "json property made required",
+			format:   FormatJSON,
+			mode:     ModeBackward,
+			previous: jsonUser,
+			next:     replace(jsonUser, `"required": ["id"]`, `"required": ["id", "name"]`),
+			expected: []Incompatibility{{Path: "name", Message: "property is required in the new schema but not in the previous schema"}},
+		},
+		{
+			name:     "json integer widened to number is backward but not forward compatible",
+			format:   FormatJSON,
+			mode:     ModeFull,
+			previous: jsonUser,
+			next:     replace(jsonUser, `"id": {"type": "integer"}`, `"id": {"type": "number"}`),
+			expected: []Incompatibility{{Path: "id", Message: "type changed from integer to number"}},
+		},
+		{
+			name:     "json nested type changed",
+			format:   FormatJSON,
+			mode:     ModeBackward,
+			previous: jsonUser,
+			next:     replace(jsonUser, `"city": {"type": "string"}`, `"city": {"type": "boolean"}`),
+			expected: []Incompatibility{{Path: "address.city", Message: "type changed from string to boolean"}},
+		},
+		{
+			name:     "json enum value removed and length tightened",
+			format:   FormatJSON,
+			mode:     ModeBackward,
+			previous: jsonUser,
+			next:     replace(replace(jsonUser, `["ACTIVE", "INACTIVE"]`, `["ACTIVE"]`), `"maxLength": 100`, `"maxLength": 50`),
+			expected: []Incompatibility{
+				{Path: "name", Message: "maxLength changed from 100 to 50"},
+				{Path: "status", Message: "enum value \"INACTIVE\" of the previous schema is missing from the new schema"},
+			},
+		},
+		{
+			name:     "json property removed from closed content model",
+			format:   FormatJSON,
+			mode:     ModeForward,
+			previous: replace(jsonUser, `"required": ["id"]`, `"required": ["id"], "additionalProperties": false`),
+			next:     replace(replace(jsonUser, `"required": ["id"]`, `"required": ["id"], "additionalProperties": false`), `"id": {"type": "integer"},`, `"id": {"type": "integer"}, "age": {"type": "integer"},`),
+			expected: []Incompatibility{{Path: "age", Message: "property of the new schema is missing from the closed content model of the previous schema"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Check(tt.format, tt.mode, tt.previous, tt.next)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	_, err := Check("XML", ModeBackward, "<a/>", "<a/>")
+	assert.ErrorContains(t, err, `unsupported schema format "XML"`)
+
+	_, err = Check(FormatAvro, "SOMETIMES", `"string"`, `"string"`)
+	assert.ErrorContains(t, err, `unsupported compatibility mode "SOMETIMES"`)
+
+	_, err = Check(FormatAvro, ModeBackward, avroUser, `{"type": "record"}`)
+	assert.ErrorContains(t, err, "failed to parse new AVRO schema")
+
+	_, err = Check(FormatProtobuf, ModeForward, "message {", protobufUser)
+	assert.ErrorContains(t, err, "failed to parse previous Protobuf schema")
+
+	_, err = Check(FormatJSON, ModeBackward, jsonUser, "{")
+	assert.ErrorContains(t, err, "failed to parse new JSON schema")
+}
+
+func TestIncompatibilityString(t *testing.T) {
+	assert.Equal(t, "address.city: type changed from string to int", Incompatibility{Path: "address.city", Message: "type changed from string to int"}.String())
+	assert.Equal(t, "syntax changed from proto2 to proto3", Incompatibility{Message: "syntax changed from proto2 to proto3"}.String())
+}
+
+// replace returns the schema with a part replaced, failing if the part is not found to keep cases meaningful.
+func replace(schema, old, replacement string) string {
+	if !strings.Contains(schema, old) {
+		panic("test schema does not contain " + old)
+	}
+	return strings.Replace(schema, old, replacement, 1)
+}
@@ -0,0 +1,204 @@
+package schemacompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/qri-io/jsonschema"
+)
+
+// Lower and upper bound keywords, a bound can't be tightened nor added.
+var (
+	jsonLowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+	jsonUpperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+)
+
+// checkJSON checks that any document valid against the writer schema remains valid against the reader schema.
+func checkJSON(reader, writer string, d direction) ([]Incompatibility, error) {
+	readerSchema, err := parseJSONSchema(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s JSON schema: %w", d.reader, err)
+	}
+	writerSchema, err := parseJSONSchema(writer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s JSON schema: %w", d.writer, err)
+	}
+
+	c := jsonChecker{direction: d}
+	c.check("", readerSchema, writerSchema)
+	return c.incompatibilities, nil
+}
+
+// parseJSONSchema validates the schema with the same parser as the schema normalization, then returns its raw
+// content to compare keywords.
+func parseJSONSchema(schema string) (map[string]any, error) {
+	if err := json.Unmarshal([]byte(schema), &jsonschema.Schema{}); err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(schema), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+type jsonChecker struct {
+	direction         direction
+	incompatibilities []Incompatibility
+}
+
+func (c *jsonChecker) report(path, message string) {
+	c.incompatibilities = append(c.incompatibilities, Incompatibility{Path: path, Message: message})
+}
+
+func (c *jsonChecker) check(path string, reader, writer map[string]any) {
+	readerTypes, writerTypes := jsonTypes(reader), jsonTypes(writer)
+	if !jsonTypesAccepted(readerTypes, writerTypes) {
+		c.report(path, c.direction.changed("type", jsonTypesName(readerTypes), jsonTypesName(writerTypes)))
+		return
+	}
+
+	c.checkEnum(path, reader, writer)
+	c.checkBounds(path, reader, writer)
+	c.checkObject(path, reader, writer)
+
+	readerItems, readerOk := reader["items"].(map[string]any)
+	writerItems, writerOk := writer["items"].(map[string]any)
+	if readerOk && writerOk {
+		c.check(path, readerItems, writerItems)
+	}
+}
+
+func (c *jsonChecker) checkEnum(path string, reader, writer map[string]any) {
+	readerEnum, ok := reader["enum"].([]any)
+	if !ok {
+		return
+	}
+	writerEnum, ok := writer["enum"].([]any)
+	if !ok {
+		c.report(path, fmt.Sprintf("values are restricted to an enum in the %s schema but not in the %s schema", c.direction.reader, c.direction.writer))
+		return
+	}
+	for _, value := range writerEnum {
+		if !slices.ContainsFunc(readerEnum, func(v any) bool { return jsonEqual(v, value) }) {
+			c.report(path, fmt.Sprintf("enum value %s of the %s schema is missing from the %s schema", jsonString(value), c.direction.writer, c.direction.reader))
+		}
+	}
+}
+
+func (c *jsonChecker) checkBounds(path string, reader, writer map[string]any) {
+	for _, keyword := range append(slices.Clone(jsonLowerBounds), jsonUpperBounds...) {
+		readerBound, ok := reader[keyword].(float64)
+		if !ok {
+			continue
+		}
+		writerBound, ok := writer[keyword].(float64)
+		lower := slices.Contains(jsonLowerBounds, keyword)
+		if !ok {
+			c.report(path, fmt.Sprintf("%s is set in the %s schema but not in the %s schema", keyword, c.direction.reader, c.direction.writer))
+		} else if (lower && readerBound > writerBound) || (!lower && readerBound < writerBound) {
+			c.report(path, c.direction.changed(keyword, jsonString(readerBound), jsonString(writerBound)))
+		}
+	}
+}
+
+func (c *jsonChecker) checkObject(path string, reader, writer map[string]any) {
+	readerProperties, _ := reader["properties"].(map[string]any)
+	writerProperties, _ := writer["properties"].(map[string]any)
+
+	readerOpen := reader["additionalProperties"] != false
+	writerOpen := writer["additionalProperties"] != false
+	if !readerOpen && writerOpen {
+		c.report(path, fmt.Sprintf("additional properties are allowed in the %s schema but not in the %s schema", c.direction.writer, c.direction.reader))
+	}
+
+	for _, name := range sortedKeys(writerProperties) {
+		writerProperty, _ := writerProperties[name].(map[string]any)
+		readerProperty, ok := readerProperties[name].(map[string]any)
+		if !ok {
+			if !readerOpen {
+				c.report(joinPath(path, name), fmt.Sprintf("property of the %s schema is missing from the closed content model of the %s schema", c.direction.writer, c.direction.reader))
+			}
+			continue
+		}
+		if writerProperty != nil {
+			c.check(joinPath(path, name), readerProperty, writerProperty)
+		}
+	}
+
+	writerRequired := jsonStrings(writer["required"])
+	for _, name := range jsonStrings(reader["required"]) {
+		if !slices.Contains(writerRequired, name) {
+			c.report(joinPath(path, name), fmt.Sprintf("property is required in the %s schema but not in the %s schema", c.direction.reader, c.direction.writer))
+		}
+	}
+}
+
+// jsonTypes returns the types allowed by a schema, nil when any type is allowed.
+func jsonTypes(schema map[string]any) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		return jsonStrings(t)
+	}
+	return nil
+}
+
+// jsonTypesAccepted returns whether every writer type is allowed by the reader, integers being numbers.
+func jsonTypesAccepted(reader, writer []string) bool {
+	if reader == nil {
+		return true
+	}
+	if writer == nil {
+		return false
+	}
+	for _, t := range writer {
+		if !slices.Contains(reader, t) && !(t == "integer" && slices.Contains(reader, "number")) {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonTypesName(types []string) string {
+	if types == nil {
+		return "any"
+	}
+	return strings.Join(types, "|")
+}
+
+func jsonStrings(value any) []string {
+	values, _ := value.([]any)
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func jsonString(value any) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+func jsonEqual(a, b any) bool {
+	return jsonString(a) == jsonString(b)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
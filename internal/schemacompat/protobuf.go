@@ -0,0 +1,212 @@
+package schemacompat
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+// Groups of scalar types sharing the same wire encoding, a field type can change within a group.
+var protobufCompatibleScalars = [][]string{
+	{"int32", "uint32", "int64", "uint64", "bool"},
+	{"sint32", "sint64"},
+	{"fixed32", "sfixed32"},
+	{"fixed64", "sfixed64"},
+	{"string", "bytes"},
+}
+
+// protobufFile is the subset of a parsed Protobuf schema relevant for compatibility.
+type protobufFile struct {
+	syntax   string
+	pkg      string
+	messages map[string]protobufMessage
+	// Full names of the messages, in declaration order.
+	names []string
+}
+
+type protobufMessage struct {
+	// Fields by number.
+	fields map[int]protobufField
+	// Numbers of the fields, in declaration order.
+	numbers []int
+}
+
+type protobufField struct {
+	name     string
+	typ      string
+	label    string
+	oneof    string
+	required bool
+}
+
+// checkProtobuf follows the schema registry Protobuf rules to check that data written with the writer schema can be
+// read with the reader schema. Fields are matched by number as names are not part of the wire format.
+func checkProtobuf(reader, writer string, d direction) ([]Incompatibility, error) {
+	readerFile, err := parseProtobuf(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s Protobuf schema: %w", d.reader, err)
+	}
+	writerFile, err := parseProtobuf(writer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s Protobuf schema: %w", d.writer, err)
+	}
+
+	var incompatibilities []Incompatibility
+	report := func(path, message string) {
+		incompatibilities = append(incompatibilities, Incompatibility{Path: path, Message: message})
+	}
+
+	if readerFile.syntax != writerFile.syntax {
+		report("", d.changed("syntax", readerFile.syntax, writerFile.syntax))
+	}
+	if readerFile.pkg != writerFile.pkg {
+		report("", d.changed("package", orNone(readerFile.pkg), orNone(writerFile.pkg)))
+	}
+
+	for _, name := range writerFile.names {
+		writerMessage := writerFile.messages[name]
+		readerMessage, ok := readerFile.messages[name]
+		if !ok {
+			report(name, fmt.Sprintf("message of the %s schema is missing from the %s schema", d.writer, d.reader))
+			continue
+		}
+
+		for _, number := range writerMessage.numbers {
+			writerField := writerMessage.fields[number]
+			readerField, ok := readerMessage.fields[number]
+			if !ok {
+				if writerField.required {
+					report(joinPath(name, writerField.name), fmt.Sprintf("required field number %d of the %s schema is missing from the %s schema", number, d.writer, d.reader))
+				}
+				continue
+			}
+
+			path := joinPath(name, readerField.name)
+			if !protobufTypesCompatible(readerField.typ, writerField.typ) {
+				report(path, d.changed("type", readerField.typ, writerField.typ))
+			}
+			if readerField.label != writerField.label {
+				report(path, d.changed("label", orNone(readerField.label), orNone(writerField.label)))
+			}
+			// Moving a field to a new oneof is allowed, but not to one already holding other fields.
+			newOneof, previousMessage := readerField.oneof, writerMessage
+			if d == forward {
+				newOneof, previousMessage = writerField.oneof, readerMessage
+			}
+			if readerField.oneof != writerField.oneof && newOneof != "" && previousMessage.hasOneof(newOneof) {
+				report(path, fmt.Sprintf("field moved to the existing oneof %s", newOneof))
+			}
+		}
+
+		for _, number := range readerMessage.numbers {
+			readerField := readerMessage.fields[number]
+			if _, ok := writerMessage.fields[number]; !ok && readerField.required {
+				report(joinPath(name, readerField.name), fmt.Sprintf("required field is missing from the %s schema", d.writer))
+			}
+		}
+	}
+
+	return incompatibilities, nil
+}
+
+func parseProtobuf(schema string) (protobufFile, error) {
+	definition, err := proto.NewParser(strings.NewReader(schema)).Parse()
+	if err != nil {
+		return protobufFile{}, err
+	}
+
+	file := protobufFile{syntax: "proto2", messages: map[string]protobufMessage{}}
+	for _, element := range definition.Elements {
+		switch e := element.(type) {
+		case *proto.Syntax:
+			file.syntax = e.Value
+		case *proto.Package:
+			file.pkg = e.Name
+		}
+	}
+
+	var visit func(prefix string, m *proto.Message)
+	visit = func(prefix string, m *proto.Message) {
+		name := joinPath(prefix, m.Name)
+		message := protobufMessage{fields: map[int]protobufField{}}
+		add := func(f protobufField, number int) {
+			message.fields[number] = f
+			message.numbers = append(message.numbers, number)
+		}
+
+		for _, element := range m.Elements {
+			switch e := element.(type) {
+			case *proto.NormalField:
+				add(protobufField{name: e.Name, typ: e.Type, label: protobufLabel(e, file.syntax), required: e.Required}, e.Sequence)
+			case *proto.MapField:
+				add(protobufField{name: e.Name, typ: fmt.Sprintf("map<%s, %s>", e.KeyType, e.Type)}, e.Sequence)
+			case *proto.Oneof:
+				for _, oneofElement := range e.Elements {
+					if f, ok := oneofElement.(*proto.OneOfField); ok {
+						add(protobufField{name: f.Name, typ: f.Type, oneof: e.Name}, f.Sequence)
+					}
+				}
+			case *proto.Message:
+				if !e.IsExtend {
+					visit(name, e)
+				}
+			}
+		}
+
+		file.messages[name] = message
+		file.names = append(file.names, name)
+	}
+
+	for _, element := range definition.Elements {
+		if m, ok := element.(*proto.Message); ok && !m.IsExtend {
+			visit("", m)
+		}
+	}
+	return file, nil
+}
+
+// protobufLabel returns the label of a field relevant for compatibility, optional being the default in proto3.
+func protobufLabel(f *proto.NormalField, syntax string) string {
+	switch {
+	case f.Repeated:
+		return "repeated"
+	case f.Required:
+		return "required"
+	case f.Optional && syntax == "proto2":
+		return "optional"
+	}
+	return ""
+}
+
+func (m protobufMessage) hasOneof(name string) bool {
+	for _, f := range m.fields {
+		if f.oneof == name {
+			return true
+		}
+	}
+	return false
+}
+
+// protobufTypesCompatible returns whether a field can change between two types, either scalars of the same wire
+// encoding or the same message or enum type, whether qualified or not.
+func protobufTypesCompatible(reader, writer string) bool {
+	reader, writer = strings.TrimPrefix(reader, "."), strings.TrimPrefix(writer, ".")
+	if reader == writer {
+		return true
+	}
+	for _, group := range protobufCompatibleScalars {
+		if slices.Contains(group, reader) && slices.Contains(group, writer) {
+			return true
+		}
+	}
+	return strings.HasSuffix(reader, "."+writer) || strings.HasSuffix(writer, "."+reader)
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
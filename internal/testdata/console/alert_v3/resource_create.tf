@@ -0,0 +1,25 @@
+
+resource "conduktor_console_group_v2" "alert_group" {
+  name = "alert-team"
+  spec = {
+    display_name = "Alert team"
+    description  = "Team owning alerts"
+  }
+}
+
+resource "conduktor_console_alert_v3" "test" {
+  name  = "test-alert"
+  group = conduktor_console_group_v2.alert_group.name
+  spec = {
+    cluster    = "kafka-cluster"
+    type       = "TopicAlert"
+    topic_name = "orders"
+    metric     = "MessageCount"
+    operator   = "GreaterThan"
+    threshold  = 1000
+    destination = {
+      type    = "Slack"
+      channel = "alerts"
+    }
+  }
+}
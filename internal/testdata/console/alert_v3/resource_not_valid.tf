@@ -0,0 +1,16 @@
+
+resource "conduktor_console_alert_v3" "not_valid" {
+  name  = "not-valid-alert"
+  group = "alert-team"
+  spec = {
+    cluster    = "kafka-cluster"
+    type       = "ConsumerGroupAlert"
+    topic_name = "orders"
+    metric     = "OffsetLag"
+    operator   = "GreaterThan"
+    threshold  = 1000
+    destination = {
+      type = "Teams"
+    }
+  }
+}
@@ -0,0 +1,20 @@
+
+resource "conduktor_console_connector_v2" "test" {
+  name            = "connector-status-test"
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  spec = {
+    config = {
+      "connector.class" = "org.apache.kafka.connect.tools.MockSourceConnector"
+      "tasks.max"       = "1"
+      "topic"           = "click.pageviews"
+      "file"            = "/etc/kafka/consumer.properties"
+    }
+  }
+}
+
+data "conduktor_console_connector_status_v2" "test" {
+  cluster         = conduktor_console_connector_v2.test.cluster
+  connect_cluster = conduktor_console_connector_v2.test.connect_cluster
+  name            = conduktor_console_connector_v2.test.name
+}
@@ -0,0 +1,6 @@
+
+data "conduktor_console_connector_status_v2" "test" {
+  cluster         = "kafka-cluster"
+  connect_cluster = "kafka-connect"
+  name            = "connector-status-missing"
+}
@@ -10,6 +10,9 @@ resource "conduktor_console_connector_v2" "test" {
     enabled           = true
     frequency_seconds = 800
   }
+  wait_for_running = {
+    timeout = "2m"
+  }
   spec = {
     config = {
       "connector.class" = "org.apache.kafka.connect.tools.MockSourceConnector"
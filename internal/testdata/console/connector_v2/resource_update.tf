@@ -10,6 +10,10 @@ resource "conduktor_console_connector_v2" "test" {
   auto_restart = {
     enabled = false
   }
+  state = "PAUSED"
+  restart_triggers = {
+    "secret_version" = "2"
+  }
   spec = {
     config = {
       "connector.class" = "org.apache.kafka.connect.tools.MockSourceConnector"
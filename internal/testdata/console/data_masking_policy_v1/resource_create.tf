@@ -0,0 +1,20 @@
+
+resource "conduktor_console_group_v2" "compliance" {
+  name = "compliance"
+  spec = {
+    display_name = "Compliance"
+    description  = "Compliance team, seeing personal data unmasked"
+  }
+}
+
+resource "conduktor_console_data_masking_policy_v1" "test" {
+  name = "mask-pii"
+  spec = {
+    description = "Mask customer personal data"
+    fields      = ["customer.email", "customer.phone"]
+    rule = {
+      type = "MASK_ALL"
+    }
+    exempted_groups = [conduktor_console_group_v2.compliance.name]
+  }
+}
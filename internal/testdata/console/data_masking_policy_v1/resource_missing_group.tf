@@ -0,0 +1,11 @@
+
+resource "conduktor_console_data_masking_policy_v1" "missing_group" {
+  name = "mask-pii-missing-group"
+  spec = {
+    fields = ["customer.email"]
+    rule = {
+      type = "MASK_ALL"
+    }
+    exempted_groups = ["not-a-group"]
+  }
+}
@@ -0,0 +1,10 @@
+
+resource "conduktor_console_data_masking_policy_v1" "not_valid" {
+  name = "mask-pii-not-valid"
+  spec = {
+    fields = ["customer.email"]
+    rule = {
+      type = "MASK_LAST_N"
+    }
+  }
+}
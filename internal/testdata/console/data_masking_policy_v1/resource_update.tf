@@ -0,0 +1,21 @@
+
+resource "conduktor_console_group_v2" "compliance" {
+  name = "compliance"
+  spec = {
+    display_name = "Compliance"
+    description  = "Compliance team, seeing personal data unmasked"
+  }
+}
+
+resource "conduktor_console_data_masking_policy_v1" "test" {
+  name = "mask-pii"
+  spec = {
+    description = "Mask the start of customer personal data"
+    fields      = ["customer.email", "customer.phone", "customer.iban"]
+    rule = {
+      type            = "MASK_FIRST_N"
+      masking_char    = "#"
+      number_of_chars = 4
+    }
+  }
+}
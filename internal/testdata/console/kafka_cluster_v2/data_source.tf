@@ -0,0 +1,33 @@
+resource "conduktor_console_kafka_cluster_v2" "source" {
+  name = "data-source-cluster"
+  labels = {
+    "env" = "test"
+  }
+  spec = {
+    display_name      = "Data Source Cluster"
+    bootstrap_servers = "localhost:9092"
+    kafka_flavor = {
+      confluent = {
+        key                      = "confluent-key"
+        secret                   = "confluent-secret"
+        confluent_cluster_id     = "confluent-cluster-id"
+        confluent_environment_id = "confluent-environment-id"
+      }
+    }
+    schema_registry = {
+      confluent_like = {
+        url = "http://localhost:8081"
+        security = {
+          basic_auth = {
+            username = "user"
+            password = "password"
+          }
+        }
+      }
+    }
+  }
+}
+
+data "conduktor_console_kafka_cluster_v2" "test" {
+  name = conduktor_console_kafka_cluster_v2.source.name
+}
@@ -0,0 +1,3 @@
+data "conduktor_console_kafka_cluster_v2" "test" {
+  name = "unknown-cluster"
+}
@@ -0,0 +1,15 @@
+
+resource "conduktor_console_topic_v2" "timeouts" {
+  name    = "topic-with-timeouts"
+  cluster = "kafka-cluster"
+  spec = {
+    partitions         = 1
+    replication_factor = 1
+  }
+  timeouts {
+    create = "2m"
+    read   = "30s"
+    update = "2m"
+    delete = "1m"
+  }
+}
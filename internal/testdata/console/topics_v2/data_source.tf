@@ -0,0 +1,47 @@
+
+resource "conduktor_console_topic_v2" "orders" {
+  name    = "data-source-orders"
+  cluster = "kafka-cluster"
+  labels = {
+    team = "sales"
+  }
+  spec = {
+    partitions         = 1
+    replication_factor = 1
+  }
+}
+
+resource "conduktor_console_topic_v2" "payments" {
+  name    = "data-source-payments"
+  cluster = "kafka-cluster"
+  labels = {
+    team = "finance"
+  }
+  spec = {
+    partitions         = 1
+    replication_factor = 1
+  }
+}
+
+data "conduktor_console_topics_v2" "all" {
+  cluster    = "kafka-cluster"
+  name_regex = "^data-source-"
+
+  depends_on = [
+    conduktor_console_topic_v2.orders,
+    conduktor_console_topic_v2.payments,
+  ]
+}
+
+data "conduktor_console_topics_v2" "sales" {
+  cluster    = "kafka-cluster"
+  name_regex = "^data-source-"
+  labels = {
+    team = "sales"
+  }
+
+  depends_on = [
+    conduktor_console_topic_v2.orders,
+    conduktor_console_topic_v2.payments,
+  ]
+}
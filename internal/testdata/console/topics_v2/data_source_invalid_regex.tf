@@ -0,0 +1,5 @@
+
+data "conduktor_console_topics_v2" "test" {
+  cluster    = "kafka-cluster"
+  name_regex = "data-source-("
+}
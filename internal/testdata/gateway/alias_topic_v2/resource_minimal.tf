@@ -0,0 +1,7 @@
+
+resource "conduktor_gateway_alias_topic_v2" "minimal" {
+  name = "minimal-alias"
+  spec = {
+    physical_name = "minimal-physical-topic"
+  }
+}
@@ -0,0 +1,8 @@
+
+resource "conduktor_gateway_alias_topic_v2" "test" {
+  name     = "test-alias"
+  vcluster = "vcluster_alias"
+  spec = {
+    physical_name = "test-physical-topic-v2"
+  }
+}
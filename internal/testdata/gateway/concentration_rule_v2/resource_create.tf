@@ -0,0 +1,11 @@
+
+resource "conduktor_gateway_concentration_rule_v2" "test" {
+  name     = "test-concentration-rule"
+  vcluster = "vcluster_concentration"
+  spec = {
+    pattern = "test-concentrated-.*"
+    physical_topics = {
+      delete = "test-concentration-delete"
+    }
+  }
+}
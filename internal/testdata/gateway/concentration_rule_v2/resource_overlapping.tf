@@ -0,0 +1,11 @@
+
+resource "conduktor_gateway_concentration_rule_v2" "overlapping" {
+  name     = "test-overlapping-rule"
+  vcluster = "vcluster_concentration"
+  spec = {
+    pattern = "test-concentrated-eu-.*"
+    physical_topics = {
+      delete = "test-concentration-eu-delete"
+    }
+  }
+}
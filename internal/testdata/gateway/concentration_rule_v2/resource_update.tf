@@ -0,0 +1,15 @@
+
+resource "conduktor_gateway_concentration_rule_v2" "test" {
+  name     = "test-concentration-rule"
+  vcluster = "vcluster_concentration"
+  spec = {
+    pattern = "test-concentrated-.*"
+    physical_topics = {
+      delete         = "test-concentration-delete"
+      compact        = "test-concentration-compact"
+      delete_compact = "test-concentration-delete-compact"
+    }
+    auto_managed       = true
+    offset_correctness = true
+  }
+}
@@ -0,0 +1,7 @@
+
+resource "conduktor_gateway_group_v2" "minimal" {
+  name = "minimal"
+  spec = {
+    external_groups = ["minimal-ldap-group"]
+  }
+}
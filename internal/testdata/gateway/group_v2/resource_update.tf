@@ -0,0 +1,32 @@
+
+resource "conduktor_gateway_service_account_v2" "member1" {
+  name     = "test-group-member1"
+  vcluster = "vcluster_group"
+  spec = {
+    type = "LOCAL"
+  }
+}
+
+resource "conduktor_gateway_service_account_v2" "member2" {
+  name     = "test-group-member2"
+  vcluster = "vcluster_group"
+  spec = {
+    type = "LOCAL"
+  }
+}
+
+resource "conduktor_gateway_group_v2" "test" {
+  name = "test-group"
+  spec = {
+    members = [
+      {
+        vcluster = conduktor_gateway_service_account_v2.member1.vcluster
+        name     = conduktor_gateway_service_account_v2.member1.name
+      },
+      {
+        vcluster = conduktor_gateway_service_account_v2.member2.vcluster
+        name     = conduktor_gateway_service_account_v2.member2.name
+      }
+    ]
+  }
+}
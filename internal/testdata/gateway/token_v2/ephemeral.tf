@@ -0,0 +1,10 @@
+ephemeral "conduktor_gateway_token_v2" "test" {
+  username         = "user_passthrough"
+  lifetime_seconds = 3600
+}
+
+provider "echo" {
+  data = ephemeral.conduktor_gateway_token_v2.test
+}
+
+resource "echo" "test" {}
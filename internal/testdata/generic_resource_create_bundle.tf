@@ -0,0 +1,23 @@
+resource "conduktor_generic" "bundle" {
+  kind     = "Group"
+  version  = "v2"
+  name     = "generic-bundle-group"
+  manifest = <<EOT
+apiVersion: v2
+kind: Group
+metadata:
+  name: generic-bundle-group
+spec:
+  displayName: Generic bundle group
+  members:
+    - dwight.schrute@dunder.mifflin.com
+---
+apiVersion: v2
+kind: User
+metadata:
+  name: dwight.schrute@dunder.mifflin.com
+spec:
+  firstName: Dwight
+  lastName: Schrute
+EOT
+}
@@ -0,0 +1,23 @@
+resource "conduktor_generic" "gateway_bundle" {
+  kind     = "GatewayGroup"
+  version  = "v2"
+  name     = "generic-gateway-group"
+  manifest = <<EOT
+apiVersion: gateway/v2
+kind: GatewayGroup
+metadata:
+  name: generic-gateway-group
+spec:
+  members:
+    - name: generic-gateway-sa
+---
+apiVersion: gateway/v2
+kind: GatewayServiceAccount
+metadata:
+  name: generic-gateway-sa
+spec:
+  type: EXTERNAL
+  externalNames:
+    - generic-external-name
+EOT
+}
@@ -0,0 +1,16 @@
+resource "conduktor_generic" "invalid" {
+  kind     = "User"
+  version  = "v2"
+  name     = "duplicated@dunder.mifflin.com"
+  manifest = <<EOT
+apiVersion: v2
+kind: User
+metadata:
+  name: duplicated@dunder.mifflin.com
+---
+apiVersion: v2
+kind: User
+metadata:
+  name: duplicated@dunder.mifflin.com
+EOT
+}
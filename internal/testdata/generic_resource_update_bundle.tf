@@ -0,0 +1,13 @@
+resource "conduktor_generic" "bundle" {
+  kind     = "Group"
+  version  = "v2"
+  name     = "generic-bundle-group"
+  manifest = <<EOT
+apiVersion: v2
+kind: Group
+metadata:
+  name: generic-bundle-group
+spec:
+  displayName: Generic bundle group updated
+EOT
+}
@@ -0,0 +1,16 @@
+resource "conduktor_generic" "gateway_bundle" {
+  kind     = "GatewayServiceAccount"
+  version  = "v2"
+  name     = "generic-gateway-sa"
+  manifest = <<EOT
+apiVersion: gateway/v2
+kind: GatewayServiceAccount
+metadata:
+  name: generic-gateway-sa
+spec:
+  type: EXTERNAL
+  externalNames:
+    - generic-external-name
+    - generic-external-name-updated
+EOT
+}
@@ -0,0 +1,16 @@
+
+resource "conduktor_gateway_service_account_v2" "test" {
+  name     = "combined-sa"
+  vcluster = "passthrough"
+  spec = {
+    type = "LOCAL"
+  }
+}
+
+resource "conduktor_console_group_v2" "test" {
+  name = "combined-group"
+  spec = {
+    display_name = "Combined provider group"
+    description  = "Group managed along with Gateway resources"
+  }
+}
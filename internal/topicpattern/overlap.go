@@ -0,0 +1,155 @@
+// Package topicpattern tells whether the topic patterns of Gateway concentration rules overlap, that is whether a
+// topic name can be matched by several of them.
+package topicpattern
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"slices"
+	"strings"
+)
+
+// alphabet holds the characters allowed in Kafka topic names. Patterns are only compared on valid topic names.
+const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789._-"
+
+// maxStates bounds the exploration of the patterns, for patterns too complex to be compared.
+const maxStates = 10000
+
+// Overlap returns whether a topic name fully matches both patterns, along with the shortest such topic name.
+// An error is returned if a pattern can't be parsed, or if the patterns are too complex to be compared.
+func Overlap(a, b string) (bool, string, error) {
+	progA, err := compile(a)
+	if err != nil {
+		return false, "", err
+	}
+	progB, err := compile(b)
+	if err != nil {
+		return false, "", err
+	}
+
+	// Breadth first exploration of the product of both patterns automata, a state being the instructions each
+	// pattern reached after consuming the same topic name prefix.
+	type state struct {
+		a, b   []uint32
+		prefix string
+	}
+	start := state{a: []uint32{uint32(progA.Start)}, b: []uint32{uint32(progB.Start)}}
+	queue := []state{start}
+	seen := map[string]bool{key(start.a, start.b): true}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		atStart := current.prefix == ""
+
+		if matches(progA, closure(progA, current.a, atStart, true)) && matches(progB, closure(progB, current.b, atStart, true)) {
+			return true, current.prefix, nil
+		}
+
+		closedA := closure(progA, current.a, atStart, false)
+		closedB := closure(progB, current.b, atStart, false)
+		for _, r := range alphabet {
+			nextA := step(progA, closedA, r)
+			nextB := step(progB, closedB, r)
+			if len(nextA) == 0 || len(nextB) == 0 {
+				continue
+			}
+			k := key(nextA, nextB)
+			if seen[k] {
+				continue
+			}
+			if len(seen) >= maxStates {
+				return false, "", fmt.Errorf("patterns %q and %q are too complex to be compared", a, b)
+			}
+			seen[k] = true
+			queue = append(queue, state{a: nextA, b: nextB, prefix: current.prefix + string(r)})
+		}
+	}
+	return false, "", nil
+}
+
+func compile(pattern string) (*syntax.Prog, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+	}
+	return syntax.Compile(re.Simplify())
+}
+
+// closure follows the instructions not consuming characters, returning the sorted rune and match instructions
+// reachable from the given ones. Line and text boundaries hold at the start and end of the topic name only, and word
+// boundaries are assumed to hold.
+func closure(prog *syntax.Prog, pcs []uint32, atStart, atEnd bool) []uint32 {
+	allowed := syntax.EmptyWordBoundary | syntax.EmptyNoWordBoundary
+	if atStart {
+		allowed |= syntax.EmptyBeginLine | syntax.EmptyBeginText
+	}
+	if atEnd {
+		allowed |= syntax.EmptyEndLine | syntax.EmptyEndText
+	}
+
+	var result []uint32
+	visited := map[uint32]bool{}
+	stack := slices.Clone(pcs)
+	for len(stack) > 0 {
+		pc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[pc] {
+			continue
+		}
+		visited[pc] = true
+
+		inst := prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			stack = append(stack, inst.Out, inst.Arg)
+		case syntax.InstCapture, syntax.InstNop:
+			stack = append(stack, inst.Out)
+		case syntax.InstEmptyWidth:
+			if syntax.EmptyOp(inst.Arg)&^allowed == 0 {
+				stack = append(stack, inst.Out)
+			}
+		case syntax.InstMatch, syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			result = append(result, pc)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// step returns the instructions reached by consuming the character from the given ones.
+func step(prog *syntax.Prog, pcs []uint32, r rune) []uint32 {
+	var next []uint32
+	for _, pc := range pcs {
+		inst := prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			if inst.MatchRune(r) {
+				next = append(next, inst.Out)
+			}
+		}
+	}
+	slices.Sort(next)
+	return slices.Compact(next)
+}
+
+func matches(prog *syntax.Prog, pcs []uint32) bool {
+	for _, pc := range pcs {
+		if prog.Inst[pc].Op == syntax.InstMatch {
+			return true
+		}
+	}
+	return false
+}
+
+func key(a, b []uint32) string {
+	var sb strings.Builder
+	for _, pc := range a {
+		fmt.Fprintf(&sb, "%d,", pc)
+	}
+	sb.WriteString("|")
+	for _, pc := range b {
+		fmt.Fprintf(&sb, "%d,", pc)
+	}
+	return sb.String()
+}
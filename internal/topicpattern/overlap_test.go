@@ -0,0 +1,47 @@
+package topicpattern
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		overlap bool
+		example string
+	}{
+		{name: "same pattern", a: "orders-.*", b: "orders-.*", overlap: true, example: "orders-"},
+		{name: "nested prefixes", a: "orders-.*", b: "orders-eu-.*", overlap: true, example: "orders-eu-"},
+		{name: "distinct prefixes", a: "orders-.*", b: "payments-.*", overlap: false},
+		{name: "distinct suffixes", a: "orders-.*-eu", b: "orders-.*-us", overlap: false},
+		{name: "prefix and suffix", a: "orders-.*", b: ".*-eu", overlap: true, example: "orders-eu"},
+		{name: "alternation", a: "(orders|payments)\\..*", b: "payments\\.[0-9]+", overlap: true, example: "payments.0"},
+		{name: "character classes", a: "topic-[a-m]", b: "topic-[n-z]", overlap: false},
+		{name: "anchors", a: "^orders$", b: "orders", overlap: true, example: "orders"},
+		{name: "case insensitive", a: "(?i)ORDERS", b: "orders", overlap: true, example: "orders"},
+		{name: "invalid topic characters only", a: "orders/.*", b: "orders.*", overlap: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlap, example, err := Overlap(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.overlap, overlap)
+			assert.Equal(t, tt.example, example)
+
+			// Overlapping is symmetric
+			overlap, _, err = Overlap(tt.b, tt.a)
+			require.NoError(t, err)
+			assert.Equal(t, tt.overlap, overlap)
+		})
+	}
+}
+
+func TestOverlapInvalidPattern(t *testing.T) {
+	_, _, err := Overlap("orders-(", "orders-.*")
+	assert.ErrorContains(t, err, `invalid pattern "orders-("`)
+}
@@ -0,0 +1,157 @@
+// Package topicpolicy evaluates the constraints of Console topic policies against a topic, the same way Console does
+// when a topic owned by an application instance is applied.
+package topicpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+)
+
+// Violation is a topic field not satisfying a constraint of a topic policy.
+type Violation struct {
+	// Name of the topic policy declaring the constraint.
+	Policy string
+	// Path of the topic field the constraint applies to, like `spec.configs.retention.ms`.
+	Path    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s (topic policy %s)", v.Path, v.Message, v.Policy)
+}
+
+// Evaluate returns the violations of the constraints of a topic policy by the given topic, ordered by path.
+func Evaluate(policy *console.TopicPolicyResource, topic *console.TopicConsoleResource) []Violation {
+	fields := Fields(topic)
+
+	keys := make([]string, 0, len(policy.Spec.Policies))
+	for key := range policy.Spec.Policies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []Violation
+	for _, key := range keys {
+		for _, violation := range EvaluateConstraint(key, policy.Spec.Policies[key], fields) {
+			violation.Policy = policy.Metadata.Name
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// EvaluateConstraint returns the violations of a single constraint applied on the given path of the topic fields
+// flattened by Fields. Missing fields only violate constraints that are not optional.
+func EvaluateConstraint(path string, constraint *console.Constraint, fields map[string]string) []Violation {
+	if constraint == nil {
+		return nil
+	}
+
+	// Allowed keys apply to the keys of a map field, like the configs or labels.
+	if c := constraint.AllowedKeys; c != nil {
+		var violations []Violation
+		prefix := path + "."
+		for _, field := range sortedFields(fields) {
+			key, ok := strings.CutPrefix(field, prefix)
+			if ok && !slices.Contains(c.Keys, key) {
+				violations = append(violations, Violation{Path: field, Message: fmt.Sprintf("key %s is not allowed, must be one of [%s]", key, strings.Join(c.Keys, ", "))})
+			}
+		}
+		return violations
+	}
+
+	value, present := fields[path]
+	if !present {
+		if constraintOptional(constraint) {
+			return nil
+		}
+		return []Violation{{Path: path, Message: "is required"}}
+	}
+
+	if message := evaluateValue(constraint, value); message != "" {
+		return []Violation{{Path: path, Message: message}}
+	}
+	return nil
+}
+
+// evaluateValue returns why a value doesn't satisfy a constraint, empty if it does.
+func evaluateValue(constraint *console.Constraint, value string) string {
+	switch {
+	case constraint.Match != nil:
+		// Patterns must match the whole value, as in Console.
+		pattern, err := regexp.Compile("^(?:" + constraint.Match.Pattern + ")$")
+		if err != nil {
+			return fmt.Sprintf("pattern %s can't be evaluated, got error: %s", constraint.Match.Pattern, err)
+		}
+		if !pattern.MatchString(value) {
+			return fmt.Sprintf("value %s does not match pattern %s", value, constraint.Match.Pattern)
+		}
+	case constraint.OneOf != nil:
+		if !slices.Contains(constraint.OneOf.Values, value) {
+			return fmt.Sprintf("value %s must be one of [%s]", value, strings.Join(constraint.OneOf.Values, ", "))
+		}
+	case constraint.NoneOf != nil:
+		if slices.Contains(constraint.NoneOf.Values, value) {
+			return fmt.Sprintf("value %s must not be one of [%s]", value, strings.Join(constraint.NoneOf.Values, ", "))
+		}
+	case constraint.Range != nil:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Sprintf("value %s is not a number", value)
+		}
+		if number < constraint.Range.Min || number > constraint.Range.Max {
+			return fmt.Sprintf("value %d is out of range [%d, %d]", number, constraint.Range.Min, constraint.Range.Max)
+		}
+	}
+	return ""
+}
+
+func constraintOptional(constraint *console.Constraint) bool {
+	switch {
+	case constraint.AllowedKeys != nil:
+		return constraint.AllowedKeys.Optional
+	case constraint.Match != nil:
+		return constraint.Match.Optional
+	case constraint.OneOf != nil:
+		return constraint.OneOf.Optional
+	case constraint.NoneOf != nil:
+		return constraint.NoneOf.Optional
+	case constraint.Range != nil:
+		return constraint.Range.Optional
+	}
+	return true
+}
+
+// Fields flattens the topic fields constraints can apply to, by their policy path.
+func Fields(topic *console.TopicConsoleResource) map[string]string {
+	fields := map[string]string{
+		"metadata.name":          topic.Metadata.Name,
+		"spec.partitions":        strconv.FormatInt(topic.Spec.Partitions, 10),
+		"spec.replicationFactor": strconv.FormatInt(topic.Spec.ReplicationFactor, 10),
+	}
+	if topic.Metadata.Description != "" {
+		fields["metadata.description"] = topic.Metadata.Description
+	}
+	for key, value := range topic.Metadata.Labels {
+		fields["metadata.labels."+key] = value
+	}
+	for key, value := range topic.Spec.Configs {
+		fields["spec.configs."+key] = value
+	}
+	return fields
+}
+
+func sortedFields(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
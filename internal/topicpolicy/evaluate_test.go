@@ -0,0 +1,124 @@
+package topicpolicy
+
+import (
+	"testing"
+
+	console "github.com/conduktor/terraform-provider-conduktor/internal/model/console"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTopic() *console.TopicConsoleResource {
+	topic := console.NewTopicConsoleResource(
+		console.TopicConsoleMetadata{
+			Name:    "website-analytics.clicks.avro",
+			Cluster: "kafka-cluster",
+			Labels:  map[string]string{"data-criticality": "C1"},
+		},
+		console.TopicConsoleSpec{
+			Partitions:        3,
+			ReplicationFactor: 3,
+			Configs: map[string]string{
+				"cleanup.policy": "delete",
+				"retention.ms":   "86400000",
+			},
+		},
+	)
+	return &topic
+}
+
+func newPolicy(policies map[string]*console.Constraint) *console.TopicPolicyResource {
+	policy := console.NewTopicPolicyResource("policy", console.TopicPolicySpec{Policies: policies})
+	return &policy
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[string]*console.Constraint
+		topic    func(*console.TopicConsoleResource)
+		expected []Violation
+	}{
+		{
+			name: "all constraints satisfied",
+			policies: map[string]*console.Constraint{
+				"metadata.name":                    {Match: &console.Match{Pattern: "^website-analytics.(?<event>[a-z0-9-]+).(avro|json)$"}},
+				"metadata.labels.data-criticality": {OneOf: &console.OneOf{Values: []string{"C0", "C1", "C2"}}},
+				"spec.replicationFactor":           {NoneOf: &console.NoneOf{Values: []string{"1", "2"}}},
+				"spec.configs.retention.ms":        {Range: &console.Range{Min: 3600000, Max: 604800000}},
+				"spec.configs":                     {AllowedKeys: &console.AllowedKeys{Keys: []string{"retention.ms", "cleanup.policy"}}},
+			},
+		},
+		{
+			name: "range exceeded",
+			policies: map[string]*console.Constraint{
+				"spec.configs.retention.ms": {Range: &console.Range{Min: 60000, Max: 3600000}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "spec.configs.retention.ms", Message: "value 86400000 is out of range [60000, 3600000]"}},
+		},
+		{
+			name: "range on a value that is not a number",
+			policies: map[string]*console.Constraint{
+				"spec.configs.retention.ms": {Range: &console.Range{Min: 60000, Max: 3600000}},
+			},
+			topic:    func(topic *console.TopicConsoleResource) { topic.Spec.Configs["retention.ms"] = "forever" },
+			expected: []Violation{{Policy: "policy", Path: "spec.configs.retention.ms", Message: "value forever is not a number"}},
+		},
+		{
+			name: "disallowed config value",
+			policies: map[string]*console.Constraint{
+				"spec.configs.cleanup.policy": {OneOf: &console.OneOf{Values: []string{"compact"}}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "spec.configs.cleanup.policy", Message: "value delete must be one of [compact]"}},
+		},
+		{
+			name: "forbidden value",
+			policies: map[string]*console.Constraint{
+				"spec.partitions": {NoneOf: &console.NoneOf{Values: []string{"3"}}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "spec.partitions", Message: "value 3 must not be one of [3]"}},
+		},
+		{
+			name: "pattern must match the whole value",
+			policies: map[string]*console.Constraint{
+				"metadata.name": {Match: &console.Match{Pattern: "website-analytics"}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "metadata.name", Message: "value website-analytics.clicks.avro does not match pattern website-analytics"}},
+		},
+		{
+			name: "disallowed config key",
+			policies: map[string]*console.Constraint{
+				"spec.configs": {AllowedKeys: &console.AllowedKeys{Keys: []string{"retention.ms"}}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "spec.configs.cleanup.policy", Message: "key cleanup.policy is not allowed, must be one of [retention.ms]"}},
+		},
+		{
+			name: "missing required and optional values",
+			policies: map[string]*console.Constraint{
+				"metadata.labels.owner":   {Match: &console.Match{Pattern: "team-.*"}},
+				"spec.configs.segment.ms": {Range: &console.Range{Optional: true, Min: 1, Max: 10}},
+			},
+			expected: []Violation{{Policy: "policy", Path: "metadata.labels.owner", Message: "is required"}},
+		},
+		{
+			name: "violations ordered by policy path",
+			policies: map[string]*console.Constraint{
+				"spec.partitions":                  {Range: &console.Range{Min: 6, Max: 12}},
+				"metadata.labels.data-criticality": {OneOf: &console.OneOf{Values: []string{"C0"}}},
+			},
+			expected: []Violation{
+				{Policy: "policy", Path: "metadata.labels.data-criticality", Message: "value C1 must be one of [C0]"},
+				{Policy: "policy", Path: "spec.partitions", Message: "value 3 is out of range [6, 12]"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic := newTopic()
+			if tt.topic != nil {
+				tt.topic(topic)
+			}
+			assert.Equal(t, tt.expected, Evaluate(newPolicy(tt.policies), topic))
+		})
+	}
+}